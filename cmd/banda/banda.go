@@ -26,4 +26,7 @@ func main() {
 func init() {
 	// bandaCmd.AddCommand(banda_command.InitCmd)
 	bandaCmd.AddCommand(banda_command.ServeCmd)
+	bandaCmd.AddCommand(banda_command.RatesCmd)
+	bandaCmd.AddCommand(banda_command.ImportCmd)
+	bandaCmd.AddCommand(banda_command.ExportCmd)
 }
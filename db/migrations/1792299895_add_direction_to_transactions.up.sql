@@ -0,0 +1 @@
+ALTER TABLE transactions ADD COLUMN direction VARCHAR(255) NOT NULL DEFAULT 'Expense';
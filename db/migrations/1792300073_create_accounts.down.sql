@@ -0,0 +1,3 @@
+ALTER TABLE subscriptions DROP COLUMN account_id;
+ALTER TABLE transactions DROP COLUMN account_id;
+DROP TABLE accounts;
@@ -0,0 +1,18 @@
+CREATE TABLE accounts (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       name VARCHAR(255) NOT NULL UNIQUE,
+       account_type VARCHAR(255) NOT NULL,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO accounts (name, account_type) VALUES ('Cash', 'Cash');
+
+ALTER TABLE transactions ADD COLUMN account_id UUID REFERENCES accounts (id);
+UPDATE transactions SET account_id = (SELECT id FROM accounts WHERE name = 'Cash');
+ALTER TABLE transactions ALTER COLUMN account_id SET NOT NULL;
+CREATE INDEX transactions_account_id_created_at_idx ON transactions (account_id, created_at);
+
+ALTER TABLE subscriptions ADD COLUMN account_id UUID REFERENCES accounts (id);
+UPDATE subscriptions SET account_id = (SELECT id FROM accounts WHERE name = 'Cash');
+ALTER TABLE subscriptions ALTER COLUMN account_id SET NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE transactions ADD COLUMN transfer_id UUID;
+CREATE INDEX transactions_transfer_id_idx ON transactions (transfer_id);
@@ -0,0 +1,4 @@
+ALTER TABLE transactions DROP COLUMN journal_entry_id;
+DROP TABLE postings;
+DROP FUNCTION check_journal_entry_balance;
+DROP TABLE journal_entries;
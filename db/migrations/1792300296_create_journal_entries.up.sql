@@ -0,0 +1,68 @@
+CREATE TABLE journal_entries (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       description VARCHAR(255) NOT NULL,
+       posted_at TIMESTAMP WITH TIME ZONE NOT NULL,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE postings (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       journal_entry_id UUID NOT NULL REFERENCES journal_entries (id),
+       transaction_id UUID REFERENCES transactions (id) DEFERRABLE INITIALLY DEFERRED,
+       account VARCHAR(255) NOT NULL,
+       amount INTEGER NOT NULL,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX postings_journal_entry_id_idx ON postings (journal_entry_id);
+CREATE INDEX postings_account_idx ON postings (account);
+
+-- Every journal entry must balance to zero once the database transaction commits.
+CREATE FUNCTION check_journal_entry_balance() RETURNS TRIGGER AS $$
+DECLARE
+       entry_id UUID;
+       total BIGINT;
+BEGIN
+       IF TG_OP = 'DELETE' THEN
+              entry_id := OLD.journal_entry_id;
+       ELSE
+              entry_id := NEW.journal_entry_id;
+       END IF;
+
+       SELECT COALESCE(SUM(amount), 0) INTO total FROM postings WHERE journal_entry_id = entry_id;
+       IF total <> 0 THEN
+              RAISE EXCEPTION 'journal entry % is unbalanced by %', entry_id, total;
+       END IF;
+
+       RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER postings_balance_check
+       AFTER INSERT OR UPDATE OR DELETE ON postings
+       DEFERRABLE INITIALLY DEFERRED
+       FOR EACH ROW EXECUTE FUNCTION check_journal_entry_balance();
+
+-- Project existing transactions onto journal entries. Transfer legs share the
+-- transfer id as their entry, everything else uses its own id. Transactions
+-- and postings reference each other, so both foreign keys are only checked on
+-- commit, letting either side be written or removed first.
+ALTER TABLE transactions ADD COLUMN journal_entry_id UUID REFERENCES journal_entries (id) DEFERRABLE INITIALLY DEFERRED;
+
+INSERT INTO journal_entries (id, description, posted_at, created_at, updated_at)
+SELECT DISTINCT ON (COALESCE(transfer_id, id)) COALESCE(transfer_id, id), description, created_at, created_at, updated_at
+FROM transactions
+ORDER BY COALESCE(transfer_id, id), created_at;
+
+UPDATE transactions SET journal_entry_id = COALESCE(transfer_id, id);
+ALTER TABLE transactions ALTER COLUMN journal_entry_id SET NOT NULL;
+
+INSERT INTO postings (journal_entry_id, transaction_id, account, amount, created_at)
+SELECT journal_entry_id, id, 'Assets:' || account_id, CASE direction WHEN 'Expense' THEN -amount ELSE amount END, created_at
+FROM transactions;
+
+INSERT INTO postings (journal_entry_id, transaction_id, account, amount, created_at)
+SELECT journal_entry_id, id, CASE direction WHEN 'Income' THEN 'Income' ELSE 'Expenses' END, CASE direction WHEN 'Income' THEN -amount ELSE amount END, created_at
+FROM transactions
+WHERE direction IN ('Income', 'Expense');
@@ -0,0 +1,3 @@
+ALTER TABLE subscriptions DROP COLUMN category_id;
+ALTER TABLE transactions DROP COLUMN category_id;
+DROP TABLE categories;
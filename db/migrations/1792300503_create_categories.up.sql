@@ -0,0 +1,14 @@
+CREATE TABLE categories (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       parent_id UUID REFERENCES categories (id),
+       name VARCHAR(255) NOT NULL,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX categories_parent_id_name_idx ON categories (COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'), name);
+
+ALTER TABLE transactions ADD COLUMN category_id UUID REFERENCES categories (id) ON DELETE SET NULL;
+CREATE INDEX transactions_category_id_idx ON transactions (category_id);
+
+ALTER TABLE subscriptions ADD COLUMN category_id UUID REFERENCES categories (id) ON DELETE SET NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE transactions DROP COLUMN ignored;
+ALTER TABLE transactions DROP COLUMN label;
+DROP TABLE rules;
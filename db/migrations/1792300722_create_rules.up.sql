@@ -0,0 +1,19 @@
+CREATE TABLE rules (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       name VARCHAR(255) NOT NULL UNIQUE,
+       priority INTEGER NOT NULL DEFAULT 0,
+       description_pattern VARCHAR(255),
+       description_contains VARCHAR(255),
+       amount_from INTEGER,
+       amount_to INTEGER,
+       created_from TIMESTAMP WITH TIME ZONE,
+       created_to TIMESTAMP WITH TIME ZONE,
+       rewrite_description VARCHAR(255),
+       label VARCHAR(255),
+       ignore BOOLEAN NOT NULL DEFAULT FALSE,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE transactions ADD COLUMN label VARCHAR(255);
+ALTER TABLE transactions ADD COLUMN ignored BOOLEAN NOT NULL DEFAULT FALSE;
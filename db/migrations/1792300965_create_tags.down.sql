@@ -0,0 +1,3 @@
+DROP TABLE subscription_tags;
+DROP TABLE transaction_tags;
+DROP TABLE tags;
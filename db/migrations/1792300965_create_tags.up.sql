@@ -0,0 +1,26 @@
+CREATE TABLE tags (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       name VARCHAR(255) NOT NULL UNIQUE,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE transaction_tags (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       tag_id UUID NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+       transaction_id UUID NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       UNIQUE (transaction_id, tag_id)
+);
+
+CREATE INDEX transaction_tags_tag_id_idx ON transaction_tags (tag_id);
+
+CREATE TABLE subscription_tags (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       tag_id UUID NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+       subscription_id UUID NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       UNIQUE (subscription_id, tag_id)
+);
+
+CREATE INDEX subscription_tags_tag_id_idx ON subscription_tags (tag_id);
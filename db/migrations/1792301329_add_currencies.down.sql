@@ -0,0 +1,38 @@
+CREATE OR REPLACE FUNCTION check_journal_entry_balance() RETURNS TRIGGER AS $$
+DECLARE
+       entry_id UUID;
+       total BIGINT;
+BEGIN
+       IF TG_OP = 'DELETE' THEN
+              entry_id := OLD.journal_entry_id;
+       ELSE
+              entry_id := NEW.journal_entry_id;
+       END IF;
+
+       SELECT COALESCE(SUM(amount), 0) INTO total FROM postings WHERE journal_entry_id = entry_id;
+       IF total <> 0 THEN
+              RAISE EXCEPTION 'journal entry % is unbalanced by %', entry_id, total;
+       END IF;
+
+       RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+UPDATE rules SET amount_from = amount_from / 100, amount_to = amount_to / 100;
+UPDATE postings SET amount = amount / 100;
+UPDATE subscriptions SET fee = fee / 100;
+UPDATE transactions SET amount = amount / 100;
+
+ALTER TABLE rules ALTER COLUMN amount_to TYPE INTEGER;
+ALTER TABLE rules ALTER COLUMN amount_from TYPE INTEGER;
+
+ALTER TABLE postings DROP COLUMN currency;
+ALTER TABLE postings ALTER COLUMN amount TYPE INTEGER;
+
+ALTER TABLE subscriptions DROP COLUMN currency;
+ALTER TABLE subscriptions ALTER COLUMN fee TYPE INTEGER;
+
+ALTER TABLE transactions DROP COLUMN currency;
+ALTER TABLE transactions ALTER COLUMN amount TYPE INTEGER;
+
+ALTER TABLE accounts DROP COLUMN currency;
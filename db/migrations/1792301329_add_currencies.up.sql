@@ -0,0 +1,48 @@
+ALTER TABLE accounts ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE transactions ALTER COLUMN amount TYPE BIGINT;
+ALTER TABLE transactions ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE subscriptions ALTER COLUMN fee TYPE BIGINT;
+ALTER TABLE subscriptions ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE postings ALTER COLUMN amount TYPE BIGINT;
+ALTER TABLE postings ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE rules ALTER COLUMN amount_from TYPE BIGINT;
+ALTER TABLE rules ALTER COLUMN amount_to TYPE BIGINT;
+
+-- Amounts were kept in whole rupiah, they are kept in the minor unit of their
+-- currency from now on, a hundredth of a rupiah.
+UPDATE transactions SET amount = amount * 100;
+UPDATE subscriptions SET fee = fee * 100;
+UPDATE postings SET amount = amount * 100;
+UPDATE rules SET amount_from = amount_from * 100, amount_to = amount_to * 100;
+
+-- Amounts in different currencies never offset each other, so every journal
+-- entry must balance to zero within each currency.
+CREATE OR REPLACE FUNCTION check_journal_entry_balance() RETURNS TRIGGER AS $$
+DECLARE
+       entry_id UUID;
+       unbalanced RECORD;
+BEGIN
+       IF TG_OP = 'DELETE' THEN
+              entry_id := OLD.journal_entry_id;
+       ELSE
+              entry_id := NEW.journal_entry_id;
+       END IF;
+
+       SELECT currency, SUM(amount) AS total INTO unbalanced
+       FROM postings
+       WHERE journal_entry_id = entry_id
+       GROUP BY currency
+       HAVING SUM(amount) <> 0
+       LIMIT 1;
+
+       IF FOUND THEN
+              RAISE EXCEPTION 'journal entry % is unbalanced by % %', entry_id, unbalanced.total, unbalanced.currency;
+       END IF;
+
+       RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,13 @@
+CREATE TABLE rates (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       date DATE NOT NULL,
+       base CHAR(3) NOT NULL,
+       quote CHAR(3) NOT NULL,
+       rate NUMERIC(24, 10) NOT NULL CHECK (rate > 0),
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       UNIQUE (date, base, quote)
+);
+
+CREATE INDEX rates_base_idx ON rates (base);
+CREATE INDEX rates_quote_idx ON rates (quote);
@@ -0,0 +1,12 @@
+CREATE TABLE transaction_splits (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       transaction_id UUID NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+       amount BIGINT NOT NULL CHECK (amount > 0),
+       currency CHAR(3) NOT NULL,
+       label VARCHAR(255) NOT NULL,
+       memo VARCHAR(255),
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX transaction_splits_transaction_id_idx ON transaction_splits (transaction_id);
+CREATE INDEX transaction_splits_label_idx ON transaction_splits (LOWER(label));
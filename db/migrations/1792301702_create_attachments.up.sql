@@ -0,0 +1,10 @@
+CREATE TABLE attachments (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       transaction_id UUID NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+       filename VARCHAR(255) NOT NULL,
+       content_type VARCHAR(255) NOT NULL,
+       size BIGINT NOT NULL CHECK (size > 0),
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX attachments_transaction_id_idx ON attachments (transaction_id);
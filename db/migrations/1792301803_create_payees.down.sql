@@ -0,0 +1,4 @@
+ALTER TABLE transactions DROP COLUMN payee_id;
+
+DROP TABLE payee_aliases;
+DROP TABLE payees;
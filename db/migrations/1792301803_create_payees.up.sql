@@ -0,0 +1,22 @@
+CREATE TABLE payees (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       name VARCHAR(255) NOT NULL,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX payees_name_idx ON payees (LOWER(name));
+
+CREATE TABLE payee_aliases (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       payee_id UUID NOT NULL REFERENCES payees (id) ON DELETE CASCADE,
+       pattern VARCHAR(255) NOT NULL,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX payee_aliases_pattern_idx ON payee_aliases (LOWER(pattern));
+CREATE INDEX payee_aliases_payee_id_idx ON payee_aliases (payee_id);
+
+ALTER TABLE transactions ADD COLUMN payee_id UUID REFERENCES payees (id) ON DELETE SET NULL;
+
+CREATE INDEX transactions_payee_id_idx ON transactions (payee_id);
@@ -0,0 +1,11 @@
+CREATE TABLE transaction_duplicates (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       transaction_id UUID NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+       duplicate_of_id UUID NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+       dismissed BOOLEAN NOT NULL DEFAULT FALSE,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       CHECK (transaction_id <> duplicate_of_id)
+);
+
+CREATE UNIQUE INDEX transaction_duplicates_pair_idx ON transaction_duplicates (LEAST(transaction_id, duplicate_of_id), GREATEST(transaction_id, duplicate_of_id));
+CREATE INDEX transaction_duplicates_duplicate_of_id_idx ON transaction_duplicates (duplicate_of_id);
@@ -0,0 +1,18 @@
+CREATE TABLE reconciliations (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       account_id UUID NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+       statement_date DATE NOT NULL,
+       closing_balance BIGINT NOT NULL,
+       currency CHAR(3) NOT NULL,
+       finished_at TIMESTAMP WITH TIME ZONE,
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX reconciliations_account_id_statement_date_idx ON reconciliations (account_id, statement_date);
+CREATE UNIQUE INDEX reconciliations_account_id_open_idx ON reconciliations (account_id) WHERE finished_at IS NULL;
+
+ALTER TABLE transactions ADD COLUMN cleared BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE transactions ADD COLUMN reconciliation_id UUID REFERENCES reconciliations (id) ON DELETE SET NULL;
+
+CREATE INDEX transactions_reconciliation_id_idx ON transactions (reconciliation_id);
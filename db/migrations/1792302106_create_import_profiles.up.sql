@@ -0,0 +1,18 @@
+CREATE TABLE import_profiles (
+       id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+       name VARCHAR(255) NOT NULL,
+       delimiter CHAR(1) NOT NULL DEFAULT ',',
+       skip_rows INTEGER NOT NULL DEFAULT 0,
+       date_column INTEGER NOT NULL,
+       date_format VARCHAR(255) NOT NULL,
+       description_column INTEGER NOT NULL,
+       amount_column INTEGER,
+       debit_column INTEGER,
+       credit_column INTEGER,
+       decimal_separator CHAR(1) NOT NULL DEFAULT '.',
+       thousand_separator VARCHAR(1) NOT NULL DEFAULT '',
+       created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+       updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX import_profiles_name_idx ON import_profiles (LOWER(name));
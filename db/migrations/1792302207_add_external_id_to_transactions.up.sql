@@ -0,0 +1,3 @@
+ALTER TABLE transactions ADD COLUMN external_id VARCHAR(255);
+
+CREATE UNIQUE INDEX transactions_account_id_external_id_idx ON transactions (account_id, external_id) WHERE external_id IS NOT NULL;
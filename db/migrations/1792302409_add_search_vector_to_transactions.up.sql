@@ -0,0 +1,6 @@
+ALTER TABLE transactions ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(description, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(label, '')), 'B')
+) STORED;
+
+CREATE INDEX transactions_search_vector_idx ON transactions USING GIN (search_vector);
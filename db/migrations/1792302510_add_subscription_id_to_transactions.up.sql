@@ -0,0 +1,29 @@
+ALTER TABLE transactions ADD COLUMN subscription_id UUID REFERENCES subscriptions (id) ON DELETE SET NULL;
+
+CREATE INDEX transactions_subscription_id_idx ON transactions (subscription_id) WHERE subscription_id IS NOT NULL;
+
+-- Charges made so far are only recognizable by their description.
+UPDATE transactions SET subscription_id = subscriptions.id
+FROM subscriptions
+WHERE subscriptions.account_id = transactions.account_id
+AND transactions.direction = 'Expense'
+AND starts_with(transactions.description, 'Pembayaran biaya langganan untuk layanan ' || subscriptions.name || ', senilai ');
+
+-- Charges of a renamed subscription keep the generated prefix but match no
+-- name any more. Report how many stay unlinked, so they can be linked by hand.
+-- Charges whose description was edited are not recognizable at all.
+DO $$
+DECLARE
+       unlinked BIGINT;
+BEGIN
+       SELECT COUNT(*) INTO unlinked
+       FROM transactions
+       WHERE subscription_id IS NULL
+       AND direction = 'Expense'
+       AND starts_with(description, 'Pembayaran biaya langganan untuk layanan ');
+
+       IF unlinked > 0 THEN
+              RAISE WARNING '% subscription charges could not be linked to their subscription', unlinked;
+       END IF;
+END;
+$$;
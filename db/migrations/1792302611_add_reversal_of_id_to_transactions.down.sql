@@ -0,0 +1 @@
+ALTER TABLE transactions DROP COLUMN reversal_of_id;
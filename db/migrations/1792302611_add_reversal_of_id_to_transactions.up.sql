@@ -0,0 +1,3 @@
+ALTER TABLE transactions ADD COLUMN reversal_of_id UUID REFERENCES transactions (id);
+
+CREATE INDEX transactions_reversal_of_id_idx ON transactions (reversal_of_id) WHERE reversal_of_id IS NOT NULL;
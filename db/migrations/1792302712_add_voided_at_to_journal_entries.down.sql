@@ -0,0 +1,5 @@
+ALTER TABLE postings DROP CONSTRAINT postings_transaction_id_fkey;
+ALTER TABLE postings ADD CONSTRAINT postings_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES transactions (id) DEFERRABLE INITIALLY DEFERRED;
+
+ALTER TABLE journal_entries DROP COLUMN voided_at;
+ALTER TABLE journal_entries DROP COLUMN reversal_of_id;
@@ -0,0 +1,10 @@
+-- A posted journal entry is never rewritten or removed. It is voided by a
+-- reversing entry instead, and its postings outlive the transactions they were
+-- projected from.
+ALTER TABLE journal_entries ADD COLUMN reversal_of_id UUID REFERENCES journal_entries (id);
+ALTER TABLE journal_entries ADD COLUMN voided_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX journal_entries_reversal_of_id_idx ON journal_entries (reversal_of_id) WHERE reversal_of_id IS NOT NULL;
+
+ALTER TABLE postings DROP CONSTRAINT postings_transaction_id_fkey;
+ALTER TABLE postings ADD CONSTRAINT postings_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES transactions (id) ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED;
@@ -0,0 +1,13 @@
+ALTER TABLE transactions DROP COLUMN search_vector;
+
+UPDATE transactions SET label = coalesce(label, rule_label), ignored = ignored OR rule_ignored;
+
+ALTER TABLE transactions DROP COLUMN rule_ignored;
+ALTER TABLE transactions DROP COLUMN rule_label;
+
+ALTER TABLE transactions ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(description, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(label, '')), 'B')
+) STORED;
+
+CREATE INDEX transactions_search_vector_idx ON transactions USING GIN (search_vector);
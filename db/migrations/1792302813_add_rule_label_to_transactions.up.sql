@@ -0,0 +1,14 @@
+-- The rules work out their label and ignored flag apart from those set by the
+-- user, so they can clear them again. Only the rules set them so far.
+ALTER TABLE transactions ADD COLUMN rule_label VARCHAR(255);
+ALTER TABLE transactions ADD COLUMN rule_ignored BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE transactions SET rule_label = label, rule_ignored = ignored, label = NULL, ignored = FALSE;
+
+ALTER TABLE transactions DROP COLUMN search_vector;
+ALTER TABLE transactions ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(description, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(label, rule_label, '')), 'B')
+) STORED;
+
+CREATE INDEX transactions_search_vector_idx ON transactions USING GIN (search_vector);
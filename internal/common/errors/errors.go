@@ -26,4 +26,16 @@ var (
 		Reason:  "INVALID_UUID_ERROR",
 		Message: "UUID is not valid. Please pass valid UUID.",
 	}
+
+	ErrCurrencyInvalid = &Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "CURRENCY_INVALID",
+		Message: "Currency is not valid. Please pass an ISO 4217 currency code.",
+	}
+
+	ErrCurrencyMismatch = &Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "CURRENCY_MISMATCH",
+		Message: "Amounts in different currencies cannot be combined.",
+	}
 )
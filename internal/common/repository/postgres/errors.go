@@ -12,4 +12,10 @@ var (
 		Reason:   "INVALID_DATABASE_SCHEMA_ERROR",
 		Template: "Mismatch or missing column: %s, Expected: %s, Found: %s",
 	}
+
+	ErrLocked = &common_errors.Error{
+		Code:    http.StatusConflict,
+		Reason:  "RECORD_LOCKED_ERROR",
+		Message: "Record is locked and cannot be modified. Please unlock it first.",
+	}
 )
@@ -0,0 +1,19 @@
+package postgres_repository
+
+import "context"
+
+type unlockKey struct{}
+
+// Unlock returns a context in which Save and Delete may modify locked rows.
+func Unlock(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unlockKey{}, true)
+}
+
+func (r *PostgresRepository[Entity, Specification, Row]) enforcesLock(ctx context.Context) bool {
+	if r.locked == "" {
+		return false
+	}
+
+	unlocked, _ := ctx.Value(unlockKey{}).(bool)
+	return !unlocked
+}
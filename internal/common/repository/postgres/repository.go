@@ -30,6 +30,7 @@ type PostgresRepository[Entity any, Specification any, Row any] struct {
 	noRow        Row
 	noRows       []Row
 	upsertSuffix string
+	locked       string
 }
 
 type PostgresIterator[Entity any, Row any] struct {
@@ -51,6 +52,10 @@ type Option[Entity any, Specification any, Row any] struct {
 	Entity          func(Row) Entity
 	Row             func(Entity) Row
 	Values          func(Row) []any
+	// Locked is a condition over the stored row, qualified with the table
+	// name, marking the row read-only. Save and Delete refuse to modify locked
+	// rows unless the context is unlocked.
+	Locked string
 }
 
 func (i *PostgresIterator[Entity, Row]) Current() (Entity, error) {
@@ -67,6 +72,17 @@ func (i *PostgresIterator[Entity, Row]) Next() bool {
 }
 
 func (r *PostgresRepository[Entity, Specification, Row]) Delete(ctx context.Context, specs ...Specification) error {
+	if r.enforcesLock(ctx) {
+		locked, err := r.exist(ctx, squirrel.And{r.filter(specs...), squirrel.Expr(r.locked)})
+		if err != nil {
+			return err
+		}
+
+		if locked {
+			return ErrLocked
+		}
+	}
+
 	query, args, err := squirrel.
 		Delete(r.tableName).
 		Where(r.filter(specs...)).
@@ -119,10 +135,14 @@ func (r *PostgresRepository[Entity, Specification, Row]) Get(ctx context.Context
 }
 
 func (r *PostgresRepository[Entity, Specification, Row]) Exist(ctx context.Context, specs ...Specification) (bool, error) {
+	return r.exist(ctx, r.filter(specs...))
+}
+
+func (r *PostgresRepository[Entity, Specification, Row]) exist(ctx context.Context, where squirrel.Sqlizer) (bool, error) {
 	builder := squirrel.
 		Select("1").
 		From(r.tableName).
-		Where(r.filter(specs...)).
+		Where(where).
 		Limit(1)
 	queryStr, queryArgs, err := builder.PlaceholderFormat(squirrel.Dollar).ToSql()
 	if err != nil {
@@ -171,21 +191,43 @@ func (r *PostgresRepository[Entity, Specification, Row]) List(ctx context.Contex
 func (r *PostgresRepository[Entity, Specification, Row]) Save(ctx context.Context, entity Entity) error {
 	row := r.row(entity)
 
+	suffix := r.upsertSuffix
+	locked := r.enforcesLock(ctx)
+	if locked {
+		suffix = fmt.Sprintf("%s WHERE NOT (%s)", suffix, r.locked)
+	}
+
 	query, args, err := squirrel.
 		Insert(r.tableName).
 		Columns(r.columns...).
 		Values(r.values(row)...).
-		Suffix(r.upsertSuffix).
+		Suffix(suffix).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
 		return err
 	}
 
-	if _, err := r.dbm.Querier(ctx).ExecContext(ctx, query, args...); err != nil {
+	result, err := r.dbm.Querier(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
 		return err
 	}
 
+	if !locked {
+		return nil
+	}
+
+	// The conditional upsert skips locked rows silently, leaving no row
+	// affected.
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrLocked
+	}
+
 	return nil
 }
 
@@ -228,6 +270,7 @@ func New[Entity any, Specification any, Row any](opt Option[Entity, Specificatio
 		columns:    opt.Columns,
 		tableName:  opt.TableName,
 		primaryKey: opt.PrimaryKey,
+		locked:     opt.Locked,
 	}
 
 	r.upsertSuffix = r.makeUpsertSuffix()
@@ -243,7 +286,7 @@ func (r *PostgresRepository[Entity, Specification, Row]) query(ctx context.Conte
 		Select(r.columns...).
 		From(r.tableName).
 		Where(r.filter(args.Filters...))
-	builder = r.dbm.Paginate(builder, args.Limit, args.Offset)
+	builder = r.dbm.Paginate(builder, args.Sort, args.Limit, args.Offset, args.Lock)
 	queryStr, queryArgs, err := builder.PlaceholderFormat(squirrel.Dollar).ToSql()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,128 @@
+package postgres_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/db/dbtest"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+)
+
+type lockedRow struct {
+	ID     uuid.UUID
+	Name   string
+	Locked bool
+}
+
+func TestPostgresRepositoryLocked(t *testing.T) {
+	db := dbtest.Open(t)
+
+	if _, err := db.Exec("CREATE TABLE locked_rows (id UUID PRIMARY KEY, name VARCHAR(255) NOT NULL, locked BOOLEAN NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if _, err := db.Exec("DROP TABLE locked_rows"); err != nil {
+			t.Error(err)
+		}
+	})
+
+	log := logger.New("test", "test")
+	repository, err := New(Option[lockedRow, uuid.UUID, lockedRow]{
+		TableName:       "locked_rows",
+		Columns:         []string{"id", "name", "locked"},
+		Schema:          map[string]string{"id": UUID, "name": CharacterVarying, "locked": Boolean},
+		PrimaryKey:      "id",
+		DatabaseManager: database_manager.New(log, db),
+		Logger:          log,
+		Filter: func(ids ...uuid.UUID) squirrel.Sqlizer {
+			return squirrel.Eq{"id": ids}
+		},
+		Scan: func(rows *sql.Rows) (lockedRow, error) {
+			row := lockedRow{}
+			err := rows.Scan(&row.ID, &row.Name, &row.Locked)
+			return row, err
+		},
+		Entity: func(row lockedRow) lockedRow { return row },
+		Row:    func(entity lockedRow) lockedRow { return entity },
+		Values: func(row lockedRow) []any {
+			return []any{row.ID, row.Name, row.Locked}
+		},
+		Locked: "locked_rows.locked",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		// stored is saved before the operation when set.
+		stored *lockedRow
+		unlock bool
+		// save is saved when set, otherwise the row is deleted.
+		save    *lockedRow
+		wantErr error
+		// want is the row stored afterwards, nil when it is gone.
+		want *lockedRow
+	}{
+		{name: "saves a new row", save: &lockedRow{Name: "new", Locked: true}, want: &lockedRow{Name: "new", Locked: true}},
+		{name: "updates an unlocked row", stored: &lockedRow{Name: "old"}, save: &lockedRow{Name: "new"}, want: &lockedRow{Name: "new"}},
+		{name: "refuses to update a locked row", stored: &lockedRow{Name: "old", Locked: true}, save: &lockedRow{Name: "new"}, wantErr: ErrLocked, want: &lockedRow{Name: "old", Locked: true}},
+		{name: "updates a locked row when unlocked", stored: &lockedRow{Name: "old", Locked: true}, unlock: true, save: &lockedRow{Name: "new"}, want: &lockedRow{Name: "new"}},
+		{name: "deletes an unlocked row", stored: &lockedRow{Name: "old"}},
+		{name: "refuses to delete a locked row", stored: &lockedRow{Name: "old", Locked: true}, wantErr: ErrLocked, want: &lockedRow{Name: "old", Locked: true}},
+		{name: "deletes a locked row when unlocked", stored: &lockedRow{Name: "old", Locked: true}, unlock: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+
+			if tt.stored != nil {
+				stored := *tt.stored
+				stored.ID = id
+				if err := repository.Save(Unlock(context.Background()), stored); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ctx := context.Background()
+			if tt.unlock {
+				ctx = Unlock(ctx)
+			}
+
+			if tt.save != nil {
+				save := *tt.save
+				save.ID = id
+				err = repository.Save(ctx, save)
+			} else {
+				err = repository.Delete(ctx, id)
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			got, err := repository.Get(context.Background(), id)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := lockedRow{}
+			if tt.want != nil {
+				want = *tt.want
+				want.ID = id
+			}
+
+			if got != want {
+				t.Errorf("stored row = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
@@ -5,4 +5,10 @@ var (
 	TimestampWithZone = "timestamp with time zone"
 	Integer           = "integer"
 	CharacterVarying  = "character varying"
+	Boolean           = "boolean"
+	BigInt            = "bigint"
+	Character         = "character"
+	Date              = "date"
+	Numeric           = "numeric"
+	TSVector          = "tsvector"
 )
@@ -11,6 +11,8 @@ type ListArgs[T any] struct {
 	Sort    common_specification.Specification
 	Limit   common_specification.Specification
 	Offset  common_specification.Specification
+	// Lock is only honoured within a database transaction.
+	Lock common_specification.Specification
 }
 
 type Repository[Entity any, Specification any] interface {
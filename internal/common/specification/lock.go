@@ -0,0 +1,12 @@
+package common_specification
+
+type LockSpecification struct {
+	Strength string
+}
+
+// ForUpdate locks the selected rows until the end of the database transaction.
+func ForUpdate() Specification {
+	return LockSpecification{
+		Strength: "FOR UPDATE",
+	}
+}
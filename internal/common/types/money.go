@@ -0,0 +1,155 @@
+package common_types
+
+import (
+	"fmt"
+	"strings"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+// DefaultCurrency is used whenever a currency is not given explicitly.
+const DefaultCurrency = "IDR"
+
+// Money is an amount in the minor units of an ISO 4217 currency, e.g. cents for
+// USD. Arithmetic between two different currencies is refused.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+var NoMoney = Money{}
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{
+		Amount:   amount,
+		Currency: NormalizeCurrency(currency),
+	}
+}
+
+// NormalizeCurrency upper-cases the code and falls back to DefaultCurrency when
+// it is empty.
+func NormalizeCurrency(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return DefaultCurrency
+	}
+
+	return currency
+}
+
+// IsCurrency reports whether the code looks like an ISO 4217 alphabetic code.
+func IsCurrency(currency string) bool {
+	if len(currency) != 3 {
+		return false
+	}
+
+	for _, r := range currency {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// currencyExponents lists the currencies whose minor unit is not a hundredth
+// of the major unit.
+var currencyExponents = map[string]int{
+	"BHD": 3, "CLP": 0, "IQD": 3, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0,
+	"KWD": 3, "LYD": 3, "OMR": 3, "PYG": 0, "TND": 3, "UGX": 0, "VND": 0,
+}
+
+// CurrencyExponent returns the number of decimal places of the currency minor
+// unit.
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+
+	return 2
+}
+
+func (m Money) Validate() error {
+	if !IsCurrency(m.Currency) {
+		return common_errors.ErrCurrencyInvalid
+	}
+
+	return nil
+}
+
+func (m Money) SameCurrency(other Money) bool {
+	return m.Currency == other.Currency
+}
+
+// Add sums both amounts. A zero value without a currency adopts the currency
+// of the other operand, so totals can start from NoMoney.
+func (m Money) Add(other Money) (Money, error) {
+	if m == NoMoney {
+		return other, nil
+	}
+
+	if other == NoMoney {
+		return m, nil
+	}
+
+	if !m.SameCurrency(other) {
+		return NoMoney, common_errors.ErrCurrencyMismatch
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	return m.Add(other.Neg())
+}
+
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+func (m Money) Abs() Money {
+	if m.Amount < 0 {
+		return m.Neg()
+	}
+
+	return m
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%s %s", m.Currency, m.Decimal())
+}
+
+// Decimal writes the minor units as a decimal with the exponent of the
+// currency, e.g. 1500.00 for 150000 IDR.
+func (m Money) Decimal() string {
+	exponent := CurrencyExponent(m.Currency)
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	if exponent == 0 {
+		return fmt.Sprintf("%s%d", sign, amount)
+	}
+
+	unit := int64(1)
+	for i := 0; i < exponent; i++ {
+		unit *= 10
+	}
+
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/unit, exponent, amount%unit)
+}
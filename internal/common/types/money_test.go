@@ -0,0 +1,211 @@
+package common_types
+
+import (
+	"errors"
+	"testing"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+func TestNormalizeCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency string
+		want     string
+	}{
+		{name: "upper-cases the code", currency: "usd", want: "USD"},
+		{name: "trims the code", currency: " eur ", want: "EUR"},
+		{name: "falls back to the default currency", currency: "", want: DefaultCurrency},
+		{name: "falls back on blank codes", currency: "   ", want: DefaultCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeCurrency(tt.currency); got != tt.want {
+				t.Errorf("NormalizeCurrency(%q) = %q, want %q", tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsCurrency(t *testing.T) {
+	tests := []struct {
+		currency string
+		want     bool
+	}{
+		{currency: "IDR", want: true},
+		{currency: "idr", want: false},
+		{currency: "ID", want: false},
+		{currency: "IDRX", want: false},
+		{currency: "I1R", want: false},
+		{currency: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.currency, func(t *testing.T) {
+			if got := IsCurrency(tt.currency); got != tt.want {
+				t.Errorf("IsCurrency(%q) = %v, want %v", tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyExponent(t *testing.T) {
+	tests := []struct {
+		currency string
+		want     int
+	}{
+		{currency: "USD", want: 2},
+		{currency: "IDR", want: 2},
+		{currency: "JPY", want: 0},
+		{currency: "KWD", want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.currency, func(t *testing.T) {
+			if got := CurrencyExponent(tt.currency); got != tt.want {
+				t.Errorf("CurrencyExponent(%q) = %d, want %d", tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		want  error
+	}{
+		{name: "valid currency", money: NewMoney(100, "usd")},
+		{name: "no currency", money: NoMoney, want: common_errors.ErrCurrencyInvalid},
+		{name: "malformed currency", money: Money{Amount: 100, Currency: "US"}, want: common_errors.ErrCurrencyInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.money.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Money
+		other   Money
+		want    Money
+		wantErr error
+	}{
+		{name: "same currency", m: NewMoney(150, "USD"), other: NewMoney(250, "USD"), want: NewMoney(400, "USD")},
+		{name: "negative operand", m: NewMoney(150, "USD"), other: NewMoney(-250, "USD"), want: NewMoney(-100, "USD")},
+		{name: "starts from no money", m: NoMoney, other: NewMoney(250, "EUR"), want: NewMoney(250, "EUR")},
+		{name: "adds no money", m: NewMoney(250, "EUR"), other: NoMoney, want: NewMoney(250, "EUR")},
+		{name: "zero amount keeps its currency", m: NewMoney(0, "USD"), other: NewMoney(250, "EUR"), wantErr: common_errors.ErrCurrencyMismatch},
+		{name: "currency mismatch", m: NewMoney(150, "USD"), other: NewMoney(250, "IDR"), wantErr: common_errors.ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Add(tt.other)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got != tt.want {
+				t.Errorf("Add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneySub(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       Money
+		other   Money
+		want    Money
+		wantErr error
+	}{
+		{name: "same currency", m: NewMoney(400, "USD"), other: NewMoney(150, "USD"), want: NewMoney(250, "USD")},
+		{name: "goes below zero", m: NewMoney(100, "USD"), other: NewMoney(150, "USD"), want: NewMoney(-50, "USD")},
+		{name: "starts from no money", m: NoMoney, other: NewMoney(150, "USD"), want: NewMoney(-150, "USD")},
+		{name: "currency mismatch", m: NewMoney(400, "USD"), other: NewMoney(150, "EUR"), wantErr: common_errors.ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.m.Sub(tt.other)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Sub() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got != tt.want {
+				t.Errorf("Sub() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneySign(t *testing.T) {
+	tests := []struct {
+		name         string
+		money        Money
+		neg          Money
+		abs          Money
+		wantZero     bool
+		wantPositive bool
+		wantNegative bool
+	}{
+		{name: "positive", money: NewMoney(100, "USD"), neg: NewMoney(-100, "USD"), abs: NewMoney(100, "USD"), wantPositive: true},
+		{name: "negative", money: NewMoney(-100, "USD"), neg: NewMoney(100, "USD"), abs: NewMoney(100, "USD"), wantNegative: true},
+		{name: "zero", money: NewMoney(0, "USD"), neg: NewMoney(0, "USD"), abs: NewMoney(0, "USD"), wantZero: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.money.Neg(); got != tt.neg {
+				t.Errorf("Neg() = %v, want %v", got, tt.neg)
+			}
+
+			if got := tt.money.Abs(); got != tt.abs {
+				t.Errorf("Abs() = %v, want %v", got, tt.abs)
+			}
+
+			if got := tt.money.IsZero(); got != tt.wantZero {
+				t.Errorf("IsZero() = %v, want %v", got, tt.wantZero)
+			}
+
+			if got := tt.money.IsPositive(); got != tt.wantPositive {
+				t.Errorf("IsPositive() = %v, want %v", got, tt.wantPositive)
+			}
+
+			if got := tt.money.IsNegative(); got != tt.wantNegative {
+				t.Errorf("IsNegative() = %v, want %v", got, tt.wantNegative)
+			}
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		want  string
+	}{
+		{name: "hundredths", money: NewMoney(150000, "IDR"), want: "IDR 1500.00"},
+		{name: "leading zero cents", money: NewMoney(105, "USD"), want: "USD 1.05"},
+		{name: "below one", money: NewMoney(-5, "USD"), want: "USD -0.05"},
+		{name: "zero exponent", money: NewMoney(1500, "JPY"), want: "JPY 1500"},
+		{name: "three decimals", money: NewMoney(1234, "KWD"), want: "KWD 1.234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.money.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package account_controller
+
+import (
+	"net/http"
+	"time"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	"github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+
+	account_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/service"
+	account_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/types"
+)
+
+type AccountController interface {
+	Register(*echo.Echo)
+	CreateAccount(c echo.Context) error
+	GetAccount(c echo.Context) error
+	ListAccounts(c echo.Context) error
+	UpdateAccount(c echo.Context) error
+	DeleteAccount(c echo.Context) error
+	GetAccountBalance(c echo.Context) error
+}
+
+type AccountControllerImpl struct {
+	logger         logger.Logger
+	accountService account_service.AccountService
+}
+
+func (ctl *AccountControllerImpl) Register(e *echo.Echo) {
+	e.POST("/v1/accounts", ctl.CreateAccount)
+	e.GET("/v1/accounts", ctl.ListAccounts)
+	e.GET("/v1/accounts/:id", ctl.GetAccount)
+	e.PATCH("/v1/accounts/:id", ctl.UpdateAccount)
+	e.DELETE("/v1/accounts/:id", ctl.DeleteAccount)
+	e.GET("/v1/accounts/:id/balance", ctl.GetAccountBalance)
+}
+
+func (ctl *AccountControllerImpl) CreateAccount(c echo.Context) error {
+	requestJSON := &CreateAccountRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.accountService.CreateAccount(c.Request().Context(), &account_service.CreateAccountParams{
+		Name:     requestJSON.Account.Name,
+		Type:     account_types.GetType(requestJSON.Account.Type),
+		Currency: requestJSON.Account.Currency,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &CreateAccountResponse{
+		Account: NewAccountResponse(result.Account, result.Balance),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (ctl *AccountControllerImpl) GetAccount(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.accountService.GetAccount(c.Request().Context(), &account_service.GetAccountParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &GetAccountResponse{
+		Account: NewAccountResponse(result.Account, result.Balance),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *AccountControllerImpl) ListAccounts(c echo.Context) error {
+	params := &account_service.ListAccountsParams{
+		TypeIs:     account_types.NoType,
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		String("name_like", &params.NameLike).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		CustomFunc("type_is", func(values []string) []error {
+			params.TypeIs = account_types.GetType(values[0])
+			return nil
+		}).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.accountService.ListAccounts(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListAccountsResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Accounts:           NewAccountsResponse(result.Accounts, result.Balances),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *AccountControllerImpl) UpdateAccount(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &UpdateAccountRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	params := &account_service.UpdateAccountParams{
+		ID: id,
+	}
+
+	if requestJSON.Account.Name != nil {
+		params.Name = common_types.Maybe[string]{Present: true, Value: *requestJSON.Account.Name}
+	}
+
+	if requestJSON.Account.Type != nil {
+		params.Type = common_types.Maybe[account_types.Type]{Present: true, Value: account_types.GetType(*requestJSON.Account.Type)}
+	}
+
+	result, err := ctl.accountService.UpdateAccount(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &UpdateAccountResponse{
+		Account: NewAccountResponse(result.Account, result.Balance),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *AccountControllerImpl) DeleteAccount(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	if _, err := ctl.accountService.DeleteAccount(c.Request().Context(), &account_service.DeleteAccountParams{
+		ID: id,
+	}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (ctl *AccountControllerImpl) GetAccountBalance(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	params := &account_service.GetAccountBalanceParams{
+		ID: id,
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		Time("as_of", &params.AsOf, time.RFC3339).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.accountService.GetAccountBalance(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &GetAccountBalanceResponse{
+		Balance: NewBalanceResponse(result.Balance),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func New(logger logger.Logger, accountService account_service.AccountService) AccountController {
+	return &AccountControllerImpl{
+		logger:         logger,
+		accountService: accountService,
+	}
+}
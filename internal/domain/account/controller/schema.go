@@ -0,0 +1,100 @@
+package account_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+)
+
+type AccountResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Currency  string    `json:"currency"`
+	Balance   int64     `json:"balance"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type AccountsResponse []AccountResponse
+
+type ListAccountsResponse struct {
+	common_schema.PaginationResponse
+	Accounts AccountsResponse `json:"accounts"`
+}
+
+type AccountRequest struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Currency string `json:"currency"`
+}
+
+type CreateAccountRequest struct {
+	Account AccountRequest `json:"account"`
+}
+
+type CreateAccountResponse struct {
+	Account AccountResponse `json:"account"`
+}
+
+type UpdateAccountFieldsRequest struct {
+	Name *string `json:"name"`
+	Type *string `json:"type"`
+}
+
+type UpdateAccountRequest struct {
+	Account UpdateAccountFieldsRequest `json:"account"`
+}
+
+type UpdateAccountResponse struct {
+	Account AccountResponse `json:"account"`
+}
+
+type GetAccountResponse struct {
+	Account AccountResponse `json:"account"`
+}
+
+type BalanceResponse struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Amount    int64     `json:"amount"`
+	Currency  string    `json:"currency"`
+	AsOf      time.Time `json:"as_of"`
+}
+
+type GetAccountBalanceResponse struct {
+	Balance BalanceResponse `json:"balance"`
+}
+
+func NewAccountResponse(account account_entity.Account, balance account_entity.Balance) AccountResponse {
+	return AccountResponse{
+		ID:        account.ID,
+		Name:      account.Name,
+		Type:      account.Type.String(),
+		Currency:  account.Currency,
+		Balance:   balance.Amount.Amount,
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+	}
+}
+
+func NewAccountsResponse(accounts account_entity.Accounts, balances map[uuid.UUID]account_entity.Balance) AccountsResponse {
+	accountsResponse := AccountsResponse{}
+
+	for _, a := range accounts {
+		accountsResponse = append(accountsResponse, NewAccountResponse(a, balances[a.ID]))
+	}
+
+	return accountsResponse
+}
+
+func NewBalanceResponse(balance account_entity.Balance) BalanceResponse {
+	return BalanceResponse{
+		AccountID: balance.AccountID,
+		Amount:    balance.Amount.Amount,
+		Currency:  balance.Amount.Currency,
+		AsOf:      balance.AsOf,
+	}
+}
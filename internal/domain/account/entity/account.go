@@ -0,0 +1,32 @@
+package account_entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/types"
+)
+
+type Account struct {
+	ID   uuid.UUID
+	Name string
+	Type account_types.Type
+	// Currency is the ISO 4217 code every transaction on the account is
+	// denominated in.
+	Currency  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Accounts []Account
+
+var NoAccount = Account{}
+var NoAccounts = []Account{}
+
+type Balance struct {
+	AccountID uuid.UUID
+	Amount    common_types.Money
+	AsOf      time.Time
+}
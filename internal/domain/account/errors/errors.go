@@ -0,0 +1,39 @@
+package account_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrAccountNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "ACCOUNT_NOT_FOUND_ERROR",
+		Message: "Account not found. Please pass valid account id.",
+	}
+
+	ErrAccountAlreadyExist = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "ACCOUNT_ALREADY_EXIST_ERROR",
+		Message: "Account already exists. Please use different name.",
+	}
+
+	ErrAccountNameEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "ACCOUNT_NAME_EMPTY_ERROR",
+		Message: "Account name is empty. Please pass non-empty name.",
+	}
+
+	ErrAccountTypeInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "ACCOUNT_TYPE_INVALID_ERROR",
+		Message: "Account type is not valid. Please choose valid account type.",
+	}
+
+	ErrAccountInUse = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "ACCOUNT_IN_USE_ERROR",
+		Message: "Account still has transactions or subscriptions. Please move or delete them first.",
+	}
+)
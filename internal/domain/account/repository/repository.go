@@ -0,0 +1,9 @@
+package account_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+)
+
+type AccountRepository common_repository.Repository[account_entity.Account, account_specification.AccountSpecification]
@@ -0,0 +1,124 @@
+package account_repository
+
+import (
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	account_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/types"
+)
+
+type PostgresAccountRow struct {
+	ID          uuid.NullUUID
+	Name        sql.NullString
+	AccountType sql.NullString
+	Currency    string
+	CreatedAt   sql.NullTime
+	UpdatedAt   sql.NullTime
+}
+
+var NoPostgresAccountRow = PostgresAccountRow{}
+
+func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (AccountRepository, error) {
+	return postgres_repository.New[account_entity.Account, account_specification.AccountSpecification, PostgresAccountRow](postgres_repository.Option[account_entity.Account, account_specification.AccountSpecification, PostgresAccountRow]{
+		Logger:    logger,
+		TableName: "accounts",
+		Schema: map[string]string{
+			"id":           postgres_repository.UUID,
+			"name":         postgres_repository.CharacterVarying,
+			"account_type": postgres_repository.CharacterVarying,
+			"currency":     postgres_repository.Character,
+			"created_at":   postgres_repository.TimestampWithZone,
+			"updated_at":   postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"name",
+			"account_type",
+			"currency",
+			"created_at",
+			"updated_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...account_specification.AccountSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case account_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				case account_specification.NameIsSpecification:
+					where = append(where, squirrel.Eq{"name": v.Name})
+				case account_specification.NameLikeSpecification:
+					where = append(where, squirrel.ILike{"name": "%" + v.Substring + "%"})
+				case account_specification.TypeIsSpecification:
+					where = append(where, squirrel.Eq{"account_type": v.Type.String()})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (PostgresAccountRow, error) {
+			row := PostgresAccountRow{}
+			if err := rows.Scan(&row.ID, &row.Name, &row.AccountType, &row.Currency, &row.CreatedAt, &row.UpdatedAt); err != nil {
+				return NoPostgresAccountRow, err
+			}
+
+			return row, nil
+		},
+		Entity: func(row PostgresAccountRow) account_entity.Account {
+			return account_entity.Account{
+				ID:        row.ID.UUID,
+				Name:      row.Name.String,
+				Type:      account_types.GetType(row.AccountType.String),
+				Currency:  row.Currency,
+				CreatedAt: row.CreatedAt.Time,
+				UpdatedAt: row.UpdatedAt.Time,
+			}
+		},
+		Row: func(account account_entity.Account) PostgresAccountRow {
+			accountType := account.Type.String()
+
+			return PostgresAccountRow{
+				ID: uuid.NullUUID{
+					UUID:  account.ID,
+					Valid: true,
+				},
+				Name: sql.NullString{
+					String: account.Name,
+					Valid:  exists.String(account.Name),
+				},
+				AccountType: sql.NullString{
+					String: accountType,
+					Valid:  accountType != "",
+				},
+				Currency: account.Currency,
+				CreatedAt: sql.NullTime{
+					Time:  account.CreatedAt,
+					Valid: exists.Date(account.CreatedAt),
+				},
+				UpdatedAt: sql.NullTime{
+					Time:  account.UpdatedAt,
+					Valid: exists.Date(account.UpdatedAt),
+				},
+			}
+		},
+		Values: func(row PostgresAccountRow) []any {
+			return []any{
+				row.ID,
+				row.Name,
+				row.AccountType,
+				row.Currency,
+				row.CreatedAt,
+				row.UpdatedAt,
+			}
+		},
+	})
+}
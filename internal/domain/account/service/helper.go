@@ -0,0 +1,46 @@
+package account_service
+
+import (
+	"context"
+	"time"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+	ledger_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/types"
+)
+
+// computeBalance totals the postings to the asset account in the ledger, of
+// the journal entries posted up to the time.
+func (s *AccountServiceImpl) computeBalance(ctx context.Context, account account_entity.Account, asOf time.Time) (account_entity.Balance, error) {
+	balance := account_entity.Balance{
+		AccountID: account.ID,
+		Amount:    common_types.NewMoney(0, account.Currency),
+		AsOf:      asOf,
+	}
+
+	iterator, err := s.postingRepository.Each(ctx, common_repository.ListArgs[ledger_specification.PostingSpecification]{
+		Filters: []ledger_specification.PostingSpecification{
+			ledger_specification.AccountIs(ledger_types.Assets(account.ID)),
+			ledger_specification.PostedBefore(asOf),
+		},
+	})
+	if err != nil {
+		return balance, err
+	}
+
+	for iterator.Next() {
+		posting, err := iterator.Current()
+		if err != nil {
+			return balance, err
+		}
+
+		balance.Amount, err = balance.Amount.Add(posting.Amount)
+		if err != nil {
+			return balance, err
+		}
+	}
+
+	return balance, nil
+}
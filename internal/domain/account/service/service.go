@@ -0,0 +1,44 @@
+package account_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	account_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/repository"
+	ledger_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/repository"
+	subscription_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/repository"
+	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
+)
+
+type AccountService interface {
+	CreateAccount(ctx context.Context, params *CreateAccountParams) (*CreateAccountResult, error)
+	GetAccount(ctx context.Context, params *GetAccountParams) (*GetAccountResult, error)
+	ListAccounts(ctx context.Context, params *ListAccountsParams) (*ListAccountsResult, error)
+	UpdateAccount(ctx context.Context, params *UpdateAccountParams) (*UpdateAccountResult, error)
+	DeleteAccount(ctx context.Context, params *DeleteAccountParams) (*DeleteAccountResult, error)
+	GetAccountBalance(ctx context.Context, params *GetAccountBalanceParams) (*GetAccountBalanceResult, error)
+}
+
+type AccountServiceImpl struct {
+	accountRepository      account_repository.AccountRepository
+	transactionRepository  transaction_repository.TransactionRepository
+	subscriptionRepository subscription_repository.SubscriptionRepository
+	postingRepository      ledger_repository.PostingRepository
+	logger                 logger.Logger
+}
+
+func New(
+	logger logger.Logger,
+	accountRepository account_repository.AccountRepository,
+	transactionRepository transaction_repository.TransactionRepository,
+	subscriptionRepository subscription_repository.SubscriptionRepository,
+	postingRepository ledger_repository.PostingRepository) AccountService {
+	return &AccountServiceImpl{
+		accountRepository:      accountRepository,
+		transactionRepository:  transactionRepository,
+		subscriptionRepository: subscriptionRepository,
+		postingRepository:      postingRepository,
+		logger:                 logger,
+	}
+}
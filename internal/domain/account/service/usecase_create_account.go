@@ -0,0 +1,73 @@
+package account_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	account_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type CreateAccountParams struct {
+	Name     string
+	Type     account_types.Type
+	Currency string
+}
+
+type CreateAccountResult struct {
+	Account account_entity.Account
+	Balance account_entity.Balance
+}
+
+func (s *AccountServiceImpl) CreateAccount(ctx context.Context, params *CreateAccountParams) (*CreateAccountResult, error) {
+	now := time.Now()
+	account := account_entity.Account{
+		ID:        uuid.New(),
+		Name:      params.Name,
+		Type:      params.Type,
+		Currency:  common_types.NormalizeCurrency(params.Currency),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if !exists.String(account.Name) {
+		return nil, account_errors.ErrAccountNameEmpty
+	}
+
+	if account.Type == account_types.NoType {
+		return nil, account_errors.ErrAccountTypeInvalid
+	}
+
+	if !common_types.IsCurrency(account.Currency) {
+		return nil, common_errors.ErrCurrencyInvalid
+	}
+
+	exist, err := s.accountRepository.Exist(ctx, account_specification.NameIs(account.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if exist {
+		return nil, account_errors.ErrAccountAlreadyExist
+	}
+
+	if err := s.accountRepository.Save(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return &CreateAccountResult{
+		Account: account,
+		Balance: account_entity.Balance{
+			AccountID: account.ID,
+			Amount:    common_types.NewMoney(0, account.Currency),
+			AsOf:      now,
+		},
+	}, nil
+}
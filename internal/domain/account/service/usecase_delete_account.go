@@ -0,0 +1,50 @@
+package account_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	subscription_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/specification"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type DeleteAccountParams struct {
+	ID uuid.UUID
+}
+
+type DeleteAccountResult struct{}
+
+func (s *AccountServiceImpl) DeleteAccount(ctx context.Context, params *DeleteAccountParams) (*DeleteAccountResult, error) {
+	account, err := s.accountRepository.Get(ctx, account_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if account == account_entity.NoAccount {
+		return nil, account_errors.ErrAccountNotFound
+	}
+
+	hasTransactions, err := s.transactionRepository.Exist(ctx, transaction_specification.AccountIs(account.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	hasSubscriptions, err := s.subscriptionRepository.Exist(ctx, subscription_specification.AccountIs(account.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if hasTransactions || hasSubscriptions {
+		return nil, account_errors.ErrAccountInUse
+	}
+
+	if err := s.accountRepository.Delete(ctx, account_specification.WithID(account.ID)); err != nil {
+		return nil, err
+	}
+
+	return &DeleteAccountResult{}, nil
+}
@@ -0,0 +1,42 @@
+package account_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+)
+
+type GetAccountParams struct {
+	ID uuid.UUID
+}
+
+type GetAccountResult struct {
+	Account account_entity.Account
+	Balance account_entity.Balance
+}
+
+func (s *AccountServiceImpl) GetAccount(ctx context.Context, params *GetAccountParams) (*GetAccountResult, error) {
+	account, err := s.accountRepository.Get(ctx, account_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if account == account_entity.NoAccount {
+		return nil, account_errors.ErrAccountNotFound
+	}
+
+	balance, err := s.computeBalance(ctx, account, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetAccountResult{
+		Account: account,
+		Balance: balance,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package account_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type GetAccountBalanceParams struct {
+	ID   uuid.UUID
+	AsOf time.Time
+}
+
+type GetAccountBalanceResult struct {
+	Balance account_entity.Balance
+}
+
+func (s *AccountServiceImpl) GetAccountBalance(ctx context.Context, params *GetAccountBalanceParams) (*GetAccountBalanceResult, error) {
+	account, err := s.accountRepository.Get(ctx, account_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if account == account_entity.NoAccount {
+		return nil, account_errors.ErrAccountNotFound
+	}
+
+	asOf := params.AsOf
+	if !exists.Date(asOf) {
+		asOf = time.Now()
+	}
+
+	balance, err := s.computeBalance(ctx, account, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetAccountBalanceResult{
+		Balance: balance,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package account_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	account_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type ListAccountsParams struct {
+	NameLike   string
+	TypeIs     account_types.Type
+	Pagination common_service.PaginationParams
+}
+
+type ListAccountsResult struct {
+	Pagination common_service.PaginationResult
+	Accounts   []account_entity.Account
+	Balances   map[uuid.UUID]account_entity.Balance
+}
+
+func (s *AccountServiceImpl) ListAccounts(ctx context.Context, params *ListAccountsParams) (*ListAccountsResult, error) {
+	filters := []account_specification.AccountSpecification{}
+
+	if exists.String(params.NameLike) {
+		filters = append(filters, account_specification.NameLike(params.NameLike))
+	}
+
+	if params.TypeIs != account_types.NoType {
+		filters = append(filters, account_specification.TypeIs(params.TypeIs))
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	accounts, err := s.accountRepository.List(ctx, common_repository.ListArgs[account_specification.AccountSpecification]{
+		Filters: filters,
+		Limit:   common_specification.WithLimit(params.Pagination.Limit()),
+		Offset:  common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		s.logger.Error("account repository list error", "detail", err.Error())
+		return nil, err
+	}
+
+	size, err := s.accountRepository.Size(ctx, filters...)
+	if err != nil {
+		s.logger.Error("account repository size error", "detail", err.Error())
+		return nil, err
+	}
+
+	now := time.Now()
+	balances := map[uuid.UUID]account_entity.Balance{}
+	for _, account := range accounts {
+		balance, err := s.computeBalance(ctx, account, now)
+		if err != nil {
+			return nil, err
+		}
+
+		balances[account.ID] = balance
+	}
+
+	return &ListAccountsResult{
+		Pagination: common_service.NewPaginationResult(params.Pagination, size),
+		Accounts:   accounts,
+		Balances:   balances,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package account_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	account_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type UpdateAccountParams struct {
+	ID   uuid.UUID
+	Name common_types.Maybe[string]
+	Type common_types.Maybe[account_types.Type]
+}
+
+type UpdateAccountResult struct {
+	Account account_entity.Account
+	Balance account_entity.Balance
+}
+
+func (s *AccountServiceImpl) UpdateAccount(ctx context.Context, params *UpdateAccountParams) (*UpdateAccountResult, error) {
+	account, err := s.accountRepository.Get(ctx, account_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if account == account_entity.NoAccount {
+		return nil, account_errors.ErrAccountNotFound
+	}
+
+	if params.Name.Present && params.Name.Value != account.Name {
+		if !exists.String(params.Name.Value) {
+			return nil, account_errors.ErrAccountNameEmpty
+		}
+
+		exist, err := s.accountRepository.Exist(ctx, account_specification.NameIs(params.Name.Value))
+		if err != nil {
+			return nil, err
+		}
+
+		if exist {
+			return nil, account_errors.ErrAccountAlreadyExist
+		}
+
+		account.Name = params.Name.Value
+	}
+
+	if params.Type.Present {
+		if params.Type.Value == account_types.NoType {
+			return nil, account_errors.ErrAccountTypeInvalid
+		}
+
+		account.Type = params.Type.Value
+	}
+
+	account.UpdatedAt = time.Now()
+
+	if err := s.accountRepository.Save(ctx, account); err != nil {
+		return nil, err
+	}
+
+	balance, err := s.computeBalance(ctx, account, account.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateAccountResult{
+		Account: account,
+		Balance: balance,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package account_specification
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/types"
+)
+
+type AccountSpecification interface {
+	Call(account account_entity.Account) bool
+}
+
+type AccountSpecifications []AccountSpecification
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(account account_entity.Account) bool {
+	return spec.ID == account.ID
+}
+
+func WithID(id uuid.UUID) AccountSpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type NameIsSpecification struct {
+	Name string
+}
+
+func (spec NameIsSpecification) Call(account account_entity.Account) bool {
+	return account.Name == spec.Name
+}
+
+func NameIs(value string) AccountSpecification {
+	return NameIsSpecification{
+		Name: value,
+	}
+}
+
+type NameLikeSpecification struct {
+	Substring string
+}
+
+func (spec NameLikeSpecification) Call(account account_entity.Account) bool {
+	return strings.Contains(strings.ToLower(account.Name), strings.ToLower(spec.Substring))
+}
+
+func NameLike(value string) AccountSpecification {
+	return NameLikeSpecification{
+		Substring: value,
+	}
+}
+
+type TypeIsSpecification struct {
+	Type account_types.Type
+}
+
+func (spec TypeIsSpecification) Call(account account_entity.Account) bool {
+	return account.Type == spec.Type
+}
+
+func TypeIs(value account_types.Type) AccountSpecification {
+	return TypeIsSpecification{
+		Type: value,
+	}
+}
@@ -0,0 +1,57 @@
+package account_types
+
+import "encoding/json"
+
+type Type int
+
+const (
+	Bank Type = iota
+	Cash
+	EWallet
+	CreditCard
+)
+
+func (t Type) String() string {
+	switch t {
+	case Bank:
+		return "Bank"
+	case Cash:
+		return "Cash"
+	case EWallet:
+		return "EWallet"
+	case CreditCard:
+		return "CreditCard"
+	default:
+		return ""
+	}
+}
+
+func (t *Type) UnmarshalJSON(b []byte) error {
+	var val string
+	if err := json.Unmarshal(b, &val); err != nil {
+		return err
+	}
+	*t = GetType(val)
+	return nil
+}
+
+func (t *Type) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func GetType(str string) Type {
+	switch str {
+	case "Bank":
+		return Bank
+	case "Cash":
+		return Cash
+	case "EWallet":
+		return EWallet
+	case "CreditCard":
+		return CreditCard
+	default:
+		return NoType
+	}
+}
+
+var NoType Type = -1
@@ -0,0 +1,144 @@
+package attachment_controller
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	attachment_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/service"
+)
+
+type AttachmentController interface {
+	Register(*echo.Echo)
+	UploadAttachment(c echo.Context) error
+	ListAttachments(c echo.Context) error
+	DownloadAttachment(c echo.Context) error
+	DeleteAttachment(c echo.Context) error
+}
+
+type AttachmentControllerImpl struct {
+	logger            logger.Logger
+	attachmentService attachment_service.AttachmentService
+}
+
+func (ctl *AttachmentControllerImpl) Register(e *echo.Echo) {
+	e.POST("/v1/transactions/:id/attachments", ctl.UploadAttachment)
+	e.GET("/v1/transactions/:id/attachments", ctl.ListAttachments)
+	e.GET("/v1/transactions/:id/attachments/:attachment_id", ctl.DownloadAttachment)
+	e.DELETE("/v1/transactions/:id/attachments/:attachment_id", ctl.DeleteAttachment)
+}
+
+func (ctl *AttachmentControllerImpl) UploadAttachment(c echo.Context) error {
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := ctl.attachmentService.UploadAttachment(c.Request().Context(), &attachment_service.UploadAttachmentParams{
+		TransactionID: transactionID,
+		Filename:      header.Filename,
+		ContentType:   header.Header.Get(echo.HeaderContentType),
+		Size:          header.Size,
+		Content:       file,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &UploadAttachmentResponse{
+		Attachment: NewAttachmentResponse(result.Attachment),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (ctl *AttachmentControllerImpl) ListAttachments(c echo.Context) error {
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.attachmentService.ListAttachments(c.Request().Context(), &attachment_service.ListAttachmentsParams{
+		TransactionID: transactionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &ListAttachmentsResponse{
+		Attachments: NewAttachmentsResponse(result.Attachments),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *AttachmentControllerImpl) DownloadAttachment(c echo.Context) error {
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	id, err := uuid.Parse(c.Param("attachment_id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.attachmentService.DownloadAttachment(c.Request().Context(), &attachment_service.DownloadAttachmentParams{
+		TransactionID: transactionID,
+		ID:            id,
+	})
+	if err != nil {
+		return err
+	}
+	defer result.Content.Close()
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{
+		"filename": result.Attachment.Filename,
+	}))
+
+	return c.Stream(http.StatusOK, result.Attachment.ContentType, result.Content)
+}
+
+func (ctl *AttachmentControllerImpl) DeleteAttachment(c echo.Context) error {
+	transactionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	id, err := uuid.Parse(c.Param("attachment_id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	if _, err := ctl.attachmentService.DeleteAttachment(c.Request().Context(), &attachment_service.DeleteAttachmentParams{
+		TransactionID: transactionID,
+		ID:            id,
+	}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func New(logger logger.Logger, attachmentService attachment_service.AttachmentService) AttachmentController {
+	return &AttachmentControllerImpl{
+		logger:            logger,
+		attachmentService: attachmentService,
+	}
+}
@@ -0,0 +1,49 @@
+package attachment_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	attachment_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/entity"
+)
+
+type AttachmentResponse struct {
+	ID            uuid.UUID `json:"id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	Filename      string    `json:"filename"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type AttachmentsResponse []AttachmentResponse
+
+type UploadAttachmentResponse struct {
+	Attachment AttachmentResponse `json:"attachment"`
+}
+
+type ListAttachmentsResponse struct {
+	Attachments AttachmentsResponse `json:"attachments"`
+}
+
+func NewAttachmentResponse(attachment attachment_entity.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:            attachment.ID,
+		TransactionID: attachment.TransactionID,
+		Filename:      attachment.Filename,
+		ContentType:   attachment.ContentType,
+		Size:          attachment.Size,
+		CreatedAt:     attachment.CreatedAt,
+	}
+}
+
+func NewAttachmentsResponse(attachments attachment_entity.Attachments) AttachmentsResponse {
+	attachmentsResponse := AttachmentsResponse{}
+
+	for _, a := range attachments {
+		attachmentsResponse = append(attachmentsResponse, NewAttachmentResponse(a))
+	}
+
+	return attachmentsResponse
+}
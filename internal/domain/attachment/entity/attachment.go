@@ -0,0 +1,27 @@
+package attachment_entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attachment describes a file, e.g. a receipt, kept alongside a transaction.
+// The content itself lives in the blob storage under Key().
+type Attachment struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	Filename      string
+	ContentType   string
+	Size          int64
+	CreatedAt     time.Time
+}
+
+type Attachments []Attachment
+
+var NoAttachment = Attachment{}
+var NoAttachments = []Attachment{}
+
+func (a Attachment) Key() string {
+	return a.ID.String()
+}
@@ -0,0 +1,33 @@
+package attachment_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrAttachmentNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "ATTACHMENT_NOT_FOUND_ERROR",
+		Message: "Attachment not found. Please pass the id of an attachment of the transaction.",
+	}
+
+	ErrAttachmentEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "ATTACHMENT_EMPTY_ERROR",
+		Message: "Attachment is empty. Please upload a non-empty file.",
+	}
+
+	ErrAttachmentContentTypeInvalid = &common_errors.DynamicError{
+		Code:     http.StatusUnsupportedMediaType,
+		Reason:   "ATTACHMENT_CONTENT_TYPE_INVALID_ERROR",
+		Template: "Attachment content type '%s' is not supported. Please upload one of: %s.",
+	}
+
+	ErrAttachmentTooLarge = &common_errors.DynamicError{
+		Code:     http.StatusRequestEntityTooLarge,
+		Reason:   "ATTACHMENT_TOO_LARGE_ERROR",
+		Template: "Attachment is too large. Please upload a file of at most %d bytes.",
+	}
+)
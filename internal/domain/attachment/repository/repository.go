@@ -0,0 +1,9 @@
+package attachment_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	attachment_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/entity"
+	attachment_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/specification"
+)
+
+type AttachmentRepository common_repository.Repository[attachment_entity.Attachment, attachment_specification.AttachmentSpecification]
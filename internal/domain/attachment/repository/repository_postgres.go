@@ -0,0 +1,101 @@
+package attachment_repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	attachment_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/entity"
+	attachment_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/specification"
+)
+
+type PostgresAttachmentRow struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	Filename      string
+	ContentType   string
+	Size          int64
+	CreatedAt     time.Time
+}
+
+func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (AttachmentRepository, error) {
+	return postgres_repository.New[attachment_entity.Attachment, attachment_specification.AttachmentSpecification, *PostgresAttachmentRow](postgres_repository.Option[attachment_entity.Attachment, attachment_specification.AttachmentSpecification, *PostgresAttachmentRow]{
+		Logger:    logger,
+		TableName: "attachments",
+		Schema: map[string]string{
+			"id":             postgres_repository.UUID,
+			"transaction_id": postgres_repository.UUID,
+			"filename":       postgres_repository.CharacterVarying,
+			"content_type":   postgres_repository.CharacterVarying,
+			"size":           postgres_repository.BigInt,
+			"created_at":     postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"transaction_id",
+			"filename",
+			"content_type",
+			"size",
+			"created_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...attachment_specification.AttachmentSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case attachment_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				case attachment_specification.TransactionIsSpecification:
+					where = append(where, squirrel.Eq{"transaction_id": v.TransactionID})
+				case attachment_specification.TransactionInSpecification:
+					where = append(where, squirrel.Eq{"transaction_id": v.TransactionIDs})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresAttachmentRow, error) {
+			row := &PostgresAttachmentRow{}
+			if err := rows.Scan(&row.ID, &row.TransactionID, &row.Filename, &row.ContentType, &row.Size, &row.CreatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresAttachmentRow) attachment_entity.Attachment {
+			return attachment_entity.Attachment{
+				ID:            row.ID,
+				TransactionID: row.TransactionID,
+				Filename:      row.Filename,
+				ContentType:   row.ContentType,
+				Size:          row.Size,
+				CreatedAt:     row.CreatedAt,
+			}
+		},
+		Row: func(attachment attachment_entity.Attachment) *PostgresAttachmentRow {
+			return &PostgresAttachmentRow{
+				ID:            attachment.ID,
+				TransactionID: attachment.TransactionID,
+				Filename:      attachment.Filename,
+				ContentType:   attachment.ContentType,
+				Size:          attachment.Size,
+				CreatedAt:     attachment.CreatedAt,
+			}
+		},
+		Values: func(row *PostgresAttachmentRow) []any {
+			return []any{
+				row.ID,
+				row.TransactionID,
+				row.Filename,
+				row.ContentType,
+				row.Size,
+				row.CreatedAt,
+			}
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package attachment_service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	attachment_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/entity"
+	attachment_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/errors"
+	attachment_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/specification"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+)
+
+var errTooLarge = errors.New("attachment: content exceeds the maximum size")
+
+func (s *AttachmentServiceImpl) checkTransaction(ctx context.Context, id uuid.UUID) error {
+	transaction, err := s.transactionRepository.Get(ctx, transaction_specification.WithID(id))
+	if err != nil {
+		return err
+	}
+
+	if transaction == transaction_entity.NoTransaction {
+		return transaction_errors.ErrTransactionNotFound
+	}
+
+	return nil
+}
+
+func (s *AttachmentServiceImpl) getAttachment(ctx context.Context, transactionID uuid.UUID, id uuid.UUID) (attachment_entity.Attachment, error) {
+	attachment, err := s.attachmentRepository.Get(ctx, attachment_specification.WithID(id), attachment_specification.TransactionIs(transactionID))
+	if err != nil {
+		return attachment_entity.NoAttachment, err
+	}
+
+	if attachment == attachment_entity.NoAttachment {
+		return attachment_entity.NoAttachment, attachment_errors.ErrAttachmentNotFound
+	}
+
+	return attachment, nil
+}
+
+// removeBlobs deletes the content of the attachments once the surrounding
+// transaction commits, so a rollback never leaves rows without content.
+func (s *AttachmentServiceImpl) removeBlobs(ctx context.Context, attachments ...attachment_entity.Attachment) error {
+	return s.transactionManager.AfterCommit(ctx, func(ctx context.Context) error {
+		for _, attachment := range attachments {
+			if err := s.storage.Delete(ctx, attachment.Key()); err != nil {
+				s.logger.Error("attachment/REMOVE_BLOB_FAILURE", logger.String("key", attachment.Key()), logger.String("error", err.Error()))
+			}
+		}
+
+		return nil
+	})
+}
+
+// detectContentType sniffs the content instead of trusting the client. The
+// declared type is only used when sniffing cannot tell.
+func detectContentType(content *bufio.Reader, declared string) (string, error) {
+	head, err := content.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	if len(head) == 0 {
+		return "", attachment_errors.ErrAttachmentEmpty
+	}
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if contentType == "application/octet-stream" || contentType == "text/plain" {
+		if declared, _, err := mime.ParseMediaType(declared); err == nil {
+			contentType = declared
+		}
+	}
+
+	if !slices.Contains(ContentTypes, contentType) {
+		return "", attachment_errors.ErrAttachmentContentTypeInvalid.Format(contentType, strings.Join(ContentTypes, ", "))
+	}
+
+	return contentType, nil
+}
+
+// limitedReader fails once more than max bytes were read, unlike
+// io.LimitReader which silently truncates.
+type limitedReader struct {
+	r    io.Reader
+	max  int64
+	read int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.max {
+		return n, errTooLarge
+	}
+
+	return n, err
+}
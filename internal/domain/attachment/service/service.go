@@ -0,0 +1,50 @@
+package attachment_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/storage"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
+
+	attachment_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/repository"
+	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
+)
+
+// MaxSize is the largest attachment accepted, in bytes.
+const MaxSize int64 = 10 << 20
+
+// ContentTypes lists the content types accepted as attachments.
+var ContentTypes = []string{
+	"application/pdf",
+	"image/gif",
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+}
+
+type AttachmentService interface {
+	UploadAttachment(ctx context.Context, params *UploadAttachmentParams) (*UploadAttachmentResult, error)
+	ListAttachments(ctx context.Context, params *ListAttachmentsParams) (*ListAttachmentsResult, error)
+	DownloadAttachment(ctx context.Context, params *DownloadAttachmentParams) (*DownloadAttachmentResult, error)
+	DeleteAttachment(ctx context.Context, params *DeleteAttachmentParams) (*DeleteAttachmentResult, error)
+	DeleteAttachments(ctx context.Context, params *DeleteAttachmentsParams) (*DeleteAttachmentsResult, error)
+}
+
+type AttachmentServiceImpl struct {
+	logger                logger.Logger
+	attachmentRepository  attachment_repository.AttachmentRepository
+	transactionRepository transaction_repository.TransactionRepository
+	storage               storage.Storage
+	transactionManager    transaction_manager.TransactionManager
+}
+
+func New(logger logger.Logger, attachmentRepository attachment_repository.AttachmentRepository, transactionRepository transaction_repository.TransactionRepository, storage storage.Storage, transactionManager transaction_manager.TransactionManager) AttachmentService {
+	return &AttachmentServiceImpl{
+		logger:                logger,
+		attachmentRepository:  attachmentRepository,
+		transactionRepository: transactionRepository,
+		storage:               storage,
+		transactionManager:    transactionManager,
+	}
+}
@@ -0,0 +1,35 @@
+package attachment_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	attachment_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/specification"
+)
+
+type DeleteAttachmentParams struct {
+	TransactionID uuid.UUID
+	ID            uuid.UUID
+}
+
+type DeleteAttachmentResult struct{}
+
+func (s *AttachmentServiceImpl) DeleteAttachment(ctx context.Context, params *DeleteAttachmentParams) (*DeleteAttachmentResult, error) {
+	attachment, err := s.getAttachment(ctx, params.TransactionID, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		if err := s.attachmentRepository.Delete(ctx, attachment_specification.WithID(attachment.ID)); err != nil {
+			return err
+		}
+
+		return s.removeBlobs(ctx, attachment)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &DeleteAttachmentResult{}, nil
+}
@@ -0,0 +1,51 @@
+package attachment_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	attachment_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/specification"
+)
+
+// DeleteAttachmentsParams selects every attachment of the transactions, used
+// when the transactions themselves are deleted.
+type DeleteAttachmentsParams struct {
+	TransactionIDs []uuid.UUID
+}
+
+type DeleteAttachmentsResult struct{}
+
+func (s *AttachmentServiceImpl) DeleteAttachments(ctx context.Context, params *DeleteAttachmentsParams) (*DeleteAttachmentsResult, error) {
+	if len(params.TransactionIDs) == 0 {
+		return &DeleteAttachmentsResult{}, nil
+	}
+
+	filters := []attachment_specification.AttachmentSpecification{
+		attachment_specification.TransactionIn(params.TransactionIDs...),
+	}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		attachments, err := s.attachmentRepository.List(ctx, common_repository.ListArgs[attachment_specification.AttachmentSpecification]{
+			Filters: filters,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(attachments) == 0 {
+			return nil
+		}
+
+		if err := s.attachmentRepository.Delete(ctx, filters...); err != nil {
+			return err
+		}
+
+		return s.removeBlobs(ctx, attachments...)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &DeleteAttachmentsResult{}, nil
+}
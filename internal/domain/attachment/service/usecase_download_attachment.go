@@ -0,0 +1,45 @@
+package attachment_service
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+
+	attachment_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/entity"
+	attachment_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/errors"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/storage"
+)
+
+type DownloadAttachmentParams struct {
+	TransactionID uuid.UUID
+	ID            uuid.UUID
+}
+
+// DownloadAttachmentResult holds the open content, the caller must close it.
+type DownloadAttachmentResult struct {
+	Attachment attachment_entity.Attachment
+	Content    io.ReadCloser
+}
+
+func (s *AttachmentServiceImpl) DownloadAttachment(ctx context.Context, params *DownloadAttachmentParams) (*DownloadAttachmentResult, error) {
+	attachment, err := s.getAttachment(ctx, params.TransactionID, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.storage.Get(ctx, attachment.Key())
+	if errors.Is(err, storage.ErrBlobNotFound) {
+		return nil, attachment_errors.ErrAttachmentNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &DownloadAttachmentResult{
+		Attachment: attachment,
+		Content:    content,
+	}, nil
+}
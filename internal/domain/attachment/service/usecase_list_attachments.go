@@ -0,0 +1,38 @@
+package attachment_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	attachment_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/entity"
+	attachment_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/specification"
+)
+
+type ListAttachmentsParams struct {
+	TransactionID uuid.UUID
+}
+
+type ListAttachmentsResult struct {
+	Attachments attachment_entity.Attachments
+}
+
+func (s *AttachmentServiceImpl) ListAttachments(ctx context.Context, params *ListAttachmentsParams) (*ListAttachmentsResult, error) {
+	if err := s.checkTransaction(ctx, params.TransactionID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachmentRepository.List(ctx, common_repository.ListArgs[attachment_specification.AttachmentSpecification]{
+		Filters: []attachment_specification.AttachmentSpecification{
+			attachment_specification.TransactionIs(params.TransactionID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAttachmentsResult{
+		Attachments: attachments,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package attachment_service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	attachment_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/entity"
+	attachment_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/errors"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+)
+
+type UploadAttachmentParams struct {
+	TransactionID uuid.UUID
+	Filename      string
+	ContentType   string
+	Size          int64
+	Content       io.Reader
+}
+
+type UploadAttachmentResult struct {
+	Attachment attachment_entity.Attachment
+}
+
+func (s *AttachmentServiceImpl) UploadAttachment(ctx context.Context, params *UploadAttachmentParams) (*UploadAttachmentResult, error) {
+	if params.Size > MaxSize {
+		return nil, attachment_errors.ErrAttachmentTooLarge.Format(MaxSize)
+	}
+
+	if err := s.checkTransaction(ctx, params.TransactionID); err != nil {
+		return nil, err
+	}
+
+	content := bufio.NewReader(params.Content)
+	contentType, err := detectContentType(content, params.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := attachment_entity.Attachment{
+		ID:            uuid.New(),
+		TransactionID: params.TransactionID,
+		Filename:      filepath.Base(params.Filename),
+		ContentType:   contentType,
+		CreatedAt:     time.Now(),
+	}
+
+	counter := &limitedReader{r: content, max: MaxSize}
+	if err := s.storage.Put(ctx, attachment.Key(), counter); err != nil {
+		if errors.Is(err, errTooLarge) {
+			return nil, attachment_errors.ErrAttachmentTooLarge.Format(MaxSize)
+		}
+
+		return nil, err
+	}
+
+	attachment.Size = counter.read
+
+	if err := s.attachmentRepository.Save(ctx, attachment); err != nil {
+		if err := s.storage.Delete(ctx, attachment.Key()); err != nil {
+			s.logger.Error("attachment/REMOVE_BLOB_FAILURE", logger.String("key", attachment.Key()), logger.String("error", err.Error()))
+		}
+
+		return nil, err
+	}
+
+	return &UploadAttachmentResult{
+		Attachment: attachment,
+	}, nil
+}
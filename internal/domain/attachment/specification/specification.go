@@ -0,0 +1,59 @@
+package attachment_specification
+
+import (
+	"github.com/google/uuid"
+
+	attachment_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/entity"
+)
+
+type AttachmentSpecification interface {
+	Call(attachment attachment_entity.Attachment) bool
+}
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(attachment attachment_entity.Attachment) bool {
+	return attachment.ID == spec.ID
+}
+
+func WithID(id uuid.UUID) AttachmentSpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type TransactionIsSpecification struct {
+	TransactionID uuid.UUID
+}
+
+func (spec TransactionIsSpecification) Call(attachment attachment_entity.Attachment) bool {
+	return attachment.TransactionID == spec.TransactionID
+}
+
+func TransactionIs(transactionID uuid.UUID) AttachmentSpecification {
+	return TransactionIsSpecification{
+		TransactionID: transactionID,
+	}
+}
+
+type TransactionInSpecification struct {
+	TransactionIDs []uuid.UUID
+}
+
+func (spec TransactionInSpecification) Call(attachment attachment_entity.Attachment) bool {
+	for _, id := range spec.TransactionIDs {
+		if attachment.TransactionID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TransactionIn(transactionIDs ...uuid.UUID) AttachmentSpecification {
+	return TransactionInSpecification{
+		TransactionIDs: transactionIDs,
+	}
+}
@@ -0,0 +1,179 @@
+package category_controller
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	category_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/service"
+)
+
+type CategoryController interface {
+	Register(*echo.Echo)
+	CreateCategory(c echo.Context) error
+	GetCategory(c echo.Context) error
+	ListCategories(c echo.Context) error
+	UpdateCategory(c echo.Context) error
+	DeleteCategory(c echo.Context) error
+}
+
+type CategoryControllerImpl struct {
+	logger          logger.Logger
+	categoryService category_service.CategoryService
+}
+
+func (ctl *CategoryControllerImpl) Register(e *echo.Echo) {
+	e.POST("/v1/categories", ctl.CreateCategory)
+	e.GET("/v1/categories", ctl.ListCategories)
+	e.GET("/v1/categories/:id", ctl.GetCategory)
+	e.PATCH("/v1/categories/:id", ctl.UpdateCategory)
+	e.DELETE("/v1/categories/:id", ctl.DeleteCategory)
+}
+
+func (ctl *CategoryControllerImpl) CreateCategory(c echo.Context) error {
+	requestJSON := &CreateCategoryRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.categoryService.CreateCategory(c.Request().Context(), &category_service.CreateCategoryParams{
+		Name:     requestJSON.Category.Name,
+		ParentID: requestJSON.Category.ParentID,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &CreateCategoryResponse{
+		Category: NewCategoryResponse(result.Category),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (ctl *CategoryControllerImpl) GetCategory(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.categoryService.GetCategory(c.Request().Context(), &category_service.GetCategoryParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &GetCategoryResponse{
+		Category: NewCategoryResponse(result.Category),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *CategoryControllerImpl) ListCategories(c echo.Context) error {
+	params := &category_service.ListCategoriesParams{
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		String("name_like", &params.NameLike).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		CustomFunc("parent_id", func(values []string) []error {
+			params.ParentIs.Present = true
+			if values[0] == "" {
+				return nil
+			}
+
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.ParentIs.Value = id
+			return nil
+		}).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.categoryService.ListCategories(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListCategoriesResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Categories:         NewCategoriesResponse(result.Categories),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *CategoryControllerImpl) UpdateCategory(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &UpdateCategoryRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	params := &category_service.UpdateCategoryParams{
+		ID: id,
+	}
+
+	if requestJSON.Category.Name != nil {
+		params.Name = common_types.Maybe[string]{Present: true, Value: *requestJSON.Category.Name}
+	}
+
+	if requestJSON.Category.ParentID != nil {
+		params.ParentID = common_types.Maybe[uuid.UUID]{Present: true, Value: *requestJSON.Category.ParentID}
+	}
+
+	result, err := ctl.categoryService.UpdateCategory(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &UpdateCategoryResponse{
+		Category: NewCategoryResponse(result.Category),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *CategoryControllerImpl) DeleteCategory(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	if _, err := ctl.categoryService.DeleteCategory(c.Request().Context(), &category_service.DeleteCategoryParams{
+		ID: id,
+	}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func New(logger logger.Logger, categoryService category_service.CategoryService) CategoryController {
+	return &CategoryControllerImpl{
+		logger:          logger,
+		categoryService: categoryService,
+	}
+}
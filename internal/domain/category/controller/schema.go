@@ -0,0 +1,78 @@
+package category_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+)
+
+type CategoryResponse struct {
+	ID        uuid.UUID     `json:"id"`
+	ParentID  uuid.NullUUID `json:"parent_id"`
+	Name      string        `json:"name"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+type CategoriesResponse []CategoryResponse
+
+type ListCategoriesResponse struct {
+	common_schema.PaginationResponse
+	Categories CategoriesResponse `json:"categories"`
+}
+
+type CategoryRequest struct {
+	Name     string    `json:"name"`
+	ParentID uuid.UUID `json:"parent_id"`
+}
+
+type CreateCategoryRequest struct {
+	Category CategoryRequest `json:"category"`
+}
+
+type CreateCategoryResponse struct {
+	Category CategoryResponse `json:"category"`
+}
+
+type UpdateCategoryFieldsRequest struct {
+	Name     *string    `json:"name"`
+	ParentID *uuid.UUID `json:"parent_id"`
+}
+
+type UpdateCategoryRequest struct {
+	Category UpdateCategoryFieldsRequest `json:"category"`
+}
+
+type UpdateCategoryResponse struct {
+	Category CategoryResponse `json:"category"`
+}
+
+type GetCategoryResponse struct {
+	Category CategoryResponse `json:"category"`
+}
+
+func NewCategoryResponse(category category_entity.Category) CategoryResponse {
+	return CategoryResponse{
+		ID: category.ID,
+		ParentID: uuid.NullUUID{
+			UUID:  category.ParentID,
+			Valid: !category.IsRoot(),
+		},
+		Name:      category.Name,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+}
+
+func NewCategoriesResponse(categories category_entity.Categories) CategoriesResponse {
+	categoriesResponse := CategoriesResponse{}
+
+	for _, c := range categories {
+		categoriesResponse = append(categoriesResponse, NewCategoryResponse(c))
+	}
+
+	return categoriesResponse
+}
@@ -0,0 +1,24 @@
+package category_entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Category struct {
+	ID        uuid.UUID
+	ParentID  uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Categories []Category
+
+var NoCategory = Category{}
+var NoCategories = []Category{}
+
+func (c Category) IsRoot() bool {
+	return c.ParentID == uuid.Nil
+}
@@ -0,0 +1,39 @@
+package category_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrCategoryNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "CATEGORY_NOT_FOUND_ERROR",
+		Message: "Category not found. Please pass valid category id.",
+	}
+
+	ErrCategoryAlreadyExist = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "CATEGORY_ALREADY_EXIST_ERROR",
+		Message: "Category already exists under the same parent. Please use different name.",
+	}
+
+	ErrCategoryNameEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "CATEGORY_NAME_EMPTY_ERROR",
+		Message: "Category name is empty. Please pass non-empty name.",
+	}
+
+	ErrCategoryParentInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "CATEGORY_PARENT_INVALID_ERROR",
+		Message: "Category parent is not valid. A category cannot be placed under itself or its descendants.",
+	}
+
+	ErrCategoryHasChildren = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "CATEGORY_HAS_CHILDREN_ERROR",
+		Message: "Category still has child categories. Please move or delete them first.",
+	}
+)
@@ -0,0 +1,9 @@
+package category_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+	category_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/specification"
+)
+
+type CategoryRepository common_repository.Repository[category_entity.Category, category_specification.CategorySpecification]
@@ -0,0 +1,133 @@
+package category_repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+	category_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/specification"
+)
+
+type PostgresCategoryRow struct {
+	ID        uuid.NullUUID
+	ParentID  uuid.NullUUID
+	Name      sql.NullString
+	CreatedAt sql.NullTime
+	UpdatedAt sql.NullTime
+}
+
+var NoPostgresCategoryRow = PostgresCategoryRow{}
+
+// PostgresCategoryIn matches rows whose column points to the category or any
+// of its descendants.
+func PostgresCategoryIn(column string, categoryID uuid.UUID) squirrel.Sqlizer {
+	return squirrel.Expr(fmt.Sprintf(`%s IN (
+		WITH RECURSIVE tree AS (
+			SELECT id FROM categories WHERE id = ?
+			UNION ALL
+			SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+		)
+		SELECT id FROM tree
+	)`, column), categoryID)
+}
+
+func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (CategoryRepository, error) {
+	return postgres_repository.New[category_entity.Category, category_specification.CategorySpecification, PostgresCategoryRow](postgres_repository.Option[category_entity.Category, category_specification.CategorySpecification, PostgresCategoryRow]{
+		Logger:    logger,
+		TableName: "categories",
+		Schema: map[string]string{
+			"id":         postgres_repository.UUID,
+			"parent_id":  postgres_repository.UUID,
+			"name":       postgres_repository.CharacterVarying,
+			"created_at": postgres_repository.TimestampWithZone,
+			"updated_at": postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"parent_id",
+			"name",
+			"created_at",
+			"updated_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...category_specification.CategorySpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case category_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				case category_specification.NameIsSpecification:
+					where = append(where, squirrel.Eq{"name": v.Name})
+				case category_specification.NameLikeSpecification:
+					where = append(where, squirrel.ILike{"name": "%" + v.Substring + "%"})
+				case category_specification.ParentIsSpecification:
+					if v.ParentID == uuid.Nil {
+						where = append(where, squirrel.Eq{"parent_id": nil})
+					} else {
+						where = append(where, squirrel.Eq{"parent_id": v.ParentID})
+					}
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (PostgresCategoryRow, error) {
+			row := PostgresCategoryRow{}
+			if err := rows.Scan(&row.ID, &row.ParentID, &row.Name, &row.CreatedAt, &row.UpdatedAt); err != nil {
+				return NoPostgresCategoryRow, err
+			}
+
+			return row, nil
+		},
+		Entity: func(row PostgresCategoryRow) category_entity.Category {
+			return category_entity.Category{
+				ID:        row.ID.UUID,
+				ParentID:  row.ParentID.UUID,
+				Name:      row.Name.String,
+				CreatedAt: row.CreatedAt.Time,
+				UpdatedAt: row.UpdatedAt.Time,
+			}
+		},
+		Row: func(category category_entity.Category) PostgresCategoryRow {
+			return PostgresCategoryRow{
+				ID: uuid.NullUUID{
+					UUID:  category.ID,
+					Valid: true,
+				},
+				ParentID: uuid.NullUUID{
+					UUID:  category.ParentID,
+					Valid: !category.IsRoot(),
+				},
+				Name: sql.NullString{
+					String: category.Name,
+					Valid:  exists.String(category.Name),
+				},
+				CreatedAt: sql.NullTime{
+					Time:  category.CreatedAt,
+					Valid: exists.Date(category.CreatedAt),
+				},
+				UpdatedAt: sql.NullTime{
+					Time:  category.UpdatedAt,
+					Valid: exists.Date(category.UpdatedAt),
+				},
+			}
+		},
+		Values: func(row PostgresCategoryRow) []any {
+			return []any{
+				row.ID,
+				row.ParentID,
+				row.Name,
+				row.CreatedAt,
+				row.UpdatedAt,
+			}
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package category_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+	category_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/errors"
+	category_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/specification"
+)
+
+// checkParent makes sure the parent exists and is neither the category itself
+// nor one of its descendants.
+func (s *CategoryServiceImpl) checkParent(ctx context.Context, categoryID uuid.UUID, parentID uuid.UUID) error {
+	for id := parentID; id != uuid.Nil; {
+		if id == categoryID {
+			return category_errors.ErrCategoryParentInvalid
+		}
+
+		parent, err := s.categoryRepository.Get(ctx, category_specification.WithID(id))
+		if err != nil {
+			return err
+		}
+
+		if parent == category_entity.NoCategory {
+			return category_errors.ErrCategoryNotFound
+		}
+
+		id = parent.ParentID
+	}
+
+	return nil
+}
+
+func (s *CategoryServiceImpl) checkName(ctx context.Context, name string, parentID uuid.UUID) error {
+	exist, err := s.categoryRepository.Exist(ctx, category_specification.NameIs(name), category_specification.ParentIs(parentID))
+	if err != nil {
+		return err
+	}
+
+	if exist {
+		return category_errors.ErrCategoryAlreadyExist
+	}
+
+	return nil
+}
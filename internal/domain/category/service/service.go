@@ -0,0 +1,29 @@
+package category_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	category_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/repository"
+)
+
+type CategoryService interface {
+	CreateCategory(ctx context.Context, params *CreateCategoryParams) (*CreateCategoryResult, error)
+	GetCategory(ctx context.Context, params *GetCategoryParams) (*GetCategoryResult, error)
+	ListCategories(ctx context.Context, params *ListCategoriesParams) (*ListCategoriesResult, error)
+	UpdateCategory(ctx context.Context, params *UpdateCategoryParams) (*UpdateCategoryResult, error)
+	DeleteCategory(ctx context.Context, params *DeleteCategoryParams) (*DeleteCategoryResult, error)
+}
+
+type CategoryServiceImpl struct {
+	categoryRepository category_repository.CategoryRepository
+	logger             logger.Logger
+}
+
+func New(logger logger.Logger, categoryRepository category_repository.CategoryRepository) CategoryService {
+	return &CategoryServiceImpl{
+		categoryRepository: categoryRepository,
+		logger:             logger,
+	}
+}
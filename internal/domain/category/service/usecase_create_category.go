@@ -0,0 +1,52 @@
+package category_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+	category_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/errors"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type CreateCategoryParams struct {
+	Name     string
+	ParentID uuid.UUID
+}
+
+type CreateCategoryResult struct {
+	Category category_entity.Category
+}
+
+func (s *CategoryServiceImpl) CreateCategory(ctx context.Context, params *CreateCategoryParams) (*CreateCategoryResult, error) {
+	now := time.Now()
+	category := category_entity.Category{
+		ID:        uuid.New(),
+		ParentID:  params.ParentID,
+		Name:      params.Name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if !exists.String(category.Name) {
+		return nil, category_errors.ErrCategoryNameEmpty
+	}
+
+	if err := s.checkParent(ctx, category.ID, category.ParentID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkName(ctx, category.Name, category.ParentID); err != nil {
+		return nil, err
+	}
+
+	if err := s.categoryRepository.Save(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return &CreateCategoryResult{
+		Category: category,
+	}, nil
+}
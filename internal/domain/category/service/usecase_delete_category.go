@@ -0,0 +1,43 @@
+package category_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+	category_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/errors"
+	category_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/specification"
+)
+
+type DeleteCategoryParams struct {
+	ID uuid.UUID
+}
+
+type DeleteCategoryResult struct{}
+
+func (s *CategoryServiceImpl) DeleteCategory(ctx context.Context, params *DeleteCategoryParams) (*DeleteCategoryResult, error) {
+	category, err := s.categoryRepository.Get(ctx, category_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if category == category_entity.NoCategory {
+		return nil, category_errors.ErrCategoryNotFound
+	}
+
+	hasChildren, err := s.categoryRepository.Exist(ctx, category_specification.ParentIs(category.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if hasChildren {
+		return nil, category_errors.ErrCategoryHasChildren
+	}
+
+	if err := s.categoryRepository.Delete(ctx, category_specification.WithID(category.ID)); err != nil {
+		return nil, err
+	}
+
+	return &DeleteCategoryResult{}, nil
+}
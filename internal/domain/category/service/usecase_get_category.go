@@ -0,0 +1,34 @@
+package category_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+	category_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/errors"
+	category_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/specification"
+)
+
+type GetCategoryParams struct {
+	ID uuid.UUID
+}
+
+type GetCategoryResult struct {
+	Category category_entity.Category
+}
+
+func (s *CategoryServiceImpl) GetCategory(ctx context.Context, params *GetCategoryParams) (*GetCategoryResult, error) {
+	category, err := s.categoryRepository.Get(ctx, category_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if category == category_entity.NoCategory {
+		return nil, category_errors.ErrCategoryNotFound
+	}
+
+	return &GetCategoryResult{
+		Category: category,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package category_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+	category_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type ListCategoriesParams struct {
+	NameLike   string
+	ParentIs   common_types.Maybe[uuid.UUID]
+	Pagination common_service.PaginationParams
+}
+
+type ListCategoriesResult struct {
+	Pagination common_service.PaginationResult
+	Categories []category_entity.Category
+}
+
+func (s *CategoryServiceImpl) ListCategories(ctx context.Context, params *ListCategoriesParams) (*ListCategoriesResult, error) {
+	filters := []category_specification.CategorySpecification{}
+
+	if exists.String(params.NameLike) {
+		filters = append(filters, category_specification.NameLike(params.NameLike))
+	}
+
+	if params.ParentIs.Present {
+		filters = append(filters, category_specification.ParentIs(params.ParentIs.Value))
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	categories, err := s.categoryRepository.List(ctx, common_repository.ListArgs[category_specification.CategorySpecification]{
+		Filters: filters,
+		Limit:   common_specification.WithLimit(params.Pagination.Limit()),
+		Offset:  common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		s.logger.Error("category repository list error", "detail", err.Error())
+		return nil, err
+	}
+
+	size, err := s.categoryRepository.Size(ctx, filters...)
+	if err != nil {
+		s.logger.Error("category repository size error", "detail", err.Error())
+		return nil, err
+	}
+
+	return &ListCategoriesResult{
+		Pagination: common_service.NewPaginationResult(params.Pagination, size),
+		Categories: categories,
+	}, nil
+}
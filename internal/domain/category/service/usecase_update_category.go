@@ -0,0 +1,70 @@
+package category_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+	category_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/errors"
+	category_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type UpdateCategoryParams struct {
+	ID       uuid.UUID
+	Name     common_types.Maybe[string]
+	ParentID common_types.Maybe[uuid.UUID]
+}
+
+type UpdateCategoryResult struct {
+	Category category_entity.Category
+}
+
+func (s *CategoryServiceImpl) UpdateCategory(ctx context.Context, params *UpdateCategoryParams) (*UpdateCategoryResult, error) {
+	category, err := s.categoryRepository.Get(ctx, category_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if category == category_entity.NoCategory {
+		return nil, category_errors.ErrCategoryNotFound
+	}
+
+	renamed := params.Name.Present && params.Name.Value != category.Name
+	moved := params.ParentID.Present && params.ParentID.Value != category.ParentID
+
+	if renamed {
+		if !exists.String(params.Name.Value) {
+			return nil, category_errors.ErrCategoryNameEmpty
+		}
+
+		category.Name = params.Name.Value
+	}
+
+	if moved {
+		if err := s.checkParent(ctx, category.ID, params.ParentID.Value); err != nil {
+			return nil, err
+		}
+
+		category.ParentID = params.ParentID.Value
+	}
+
+	if renamed || moved {
+		if err := s.checkName(ctx, category.Name, category.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	category.UpdatedAt = time.Now()
+
+	if err := s.categoryRepository.Save(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return &UpdateCategoryResult{
+		Category: category,
+	}, nil
+}
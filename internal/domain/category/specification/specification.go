@@ -0,0 +1,71 @@
+package category_specification
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	category_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/entity"
+)
+
+type CategorySpecification interface {
+	Call(category category_entity.Category) bool
+}
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(category category_entity.Category) bool {
+	return spec.ID == category.ID
+}
+
+func WithID(id uuid.UUID) CategorySpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type NameIsSpecification struct {
+	Name string
+}
+
+func (spec NameIsSpecification) Call(category category_entity.Category) bool {
+	return category.Name == spec.Name
+}
+
+func NameIs(value string) CategorySpecification {
+	return NameIsSpecification{
+		Name: value,
+	}
+}
+
+type NameLikeSpecification struct {
+	Substring string
+}
+
+func (spec NameLikeSpecification) Call(category category_entity.Category) bool {
+	return strings.Contains(strings.ToLower(category.Name), strings.ToLower(spec.Substring))
+}
+
+func NameLike(value string) CategorySpecification {
+	return NameLikeSpecification{
+		Substring: value,
+	}
+}
+
+// ParentIsSpecification matches direct children of the parent. Passing
+// uuid.Nil matches root categories.
+type ParentIsSpecification struct {
+	ParentID uuid.UUID
+}
+
+func (spec ParentIsSpecification) Call(category category_entity.Category) bool {
+	return spec.ParentID == category.ParentID
+}
+
+func ParentIs(parentID uuid.UUID) CategorySpecification {
+	return ParentIsSpecification{
+		ParentID: parentID,
+	}
+}
@@ -0,0 +1,88 @@
+package export_controller
+
+import (
+	"mime"
+	"net/http"
+
+	echo "github.com/labstack/echo/v4"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	export_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/service"
+	export_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/types"
+)
+
+type ExportController interface {
+	Register(*echo.Echo)
+	ExportJournal(c echo.Context) error
+}
+
+type ExportControllerImpl struct {
+	logger        logger.Logger
+	exportService export_service.ExportService
+}
+
+func (ctl *ExportControllerImpl) Register(e *echo.Echo) {
+	e.GET("/v1/exports/journal", ctl.ExportJournal)
+}
+
+// ExportJournal downloads every transaction as a journal in the syntax of the
+// "format" query parameter: ledger, hledger or beancount.
+func (ctl *ExportControllerImpl) ExportJournal(c echo.Context) error {
+	format := export_types.GetFormat(c.QueryParam("format"))
+	writer := &downloadWriter{
+		response: c.Response(),
+		filename: "banda-lumaksa" + format.Extension(),
+	}
+
+	if _, err := ctl.exportService.ExportJournal(c.Request().Context(), &export_service.ExportJournalParams{
+		Format: format,
+		Writer: writer,
+	}); err != nil {
+		// Once the download started the status cannot change anymore, the
+		// journal is cut short instead.
+		if c.Response().Committed {
+			ctl.logger.Error("export/FAILURE", logger.String("error", err.Error()))
+			return nil
+		}
+
+		return err
+	}
+
+	// Nothing was written for an empty journal.
+	writer.commit()
+
+	return nil
+}
+
+// downloadWriter sends the download headers on the first write, so an export
+// failing before it wrote anything still answers with the error.
+type downloadWriter struct {
+	response *echo.Response
+	filename string
+}
+
+func (w *downloadWriter) Write(p []byte) (int, error) {
+	w.commit()
+
+	return w.response.Write(p)
+}
+
+func (w *downloadWriter) commit() {
+	if w.response.Committed {
+		return
+	}
+
+	w.response.Header().Set(echo.HeaderContentType, echo.MIMETextPlainCharsetUTF8)
+	w.response.Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{
+		"filename": w.filename,
+	}))
+	w.response.WriteHeader(http.StatusOK)
+}
+
+func New(logger logger.Logger, exportService export_service.ExportService) ExportController {
+	return &ExportControllerImpl{
+		logger:        logger,
+		exportService: exportService,
+	}
+}
@@ -0,0 +1,227 @@
+package export_entity
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	export_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/types"
+)
+
+// Accounts names the journal accounts that have no banda account behind them.
+type Accounts struct {
+	// Assets prefixes the name of every banda account.
+	Assets   string
+	Income   string
+	Expenses string
+	// Subscriptions prefixes the name of the subscription a charge is for.
+	Subscriptions string
+	// Transfers balances a transfer leg whose other leg is missing.
+	Transfers string
+}
+
+var DefaultAccounts = Accounts{
+	Assets:        "Assets",
+	Income:        "Income:Uncategorized",
+	Expenses:      "Expenses:Uncategorized",
+	Subscriptions: "Expenses:Subscriptions",
+	Transfers:     "Equity:Transfers",
+}
+
+// WithDefaults fills the accounts left empty with the default ones.
+func (a Accounts) WithDefaults() Accounts {
+	if a.Assets == "" {
+		a.Assets = DefaultAccounts.Assets
+	}
+
+	if a.Income == "" {
+		a.Income = DefaultAccounts.Income
+	}
+
+	if a.Expenses == "" {
+		a.Expenses = DefaultAccounts.Expenses
+	}
+
+	if a.Subscriptions == "" {
+		a.Subscriptions = DefaultAccounts.Subscriptions
+	}
+
+	if a.Transfers == "" {
+		a.Transfers = DefaultAccounts.Transfers
+	}
+
+	return a
+}
+
+func (a Accounts) Asset(name string) string {
+	return AccountName(a.Assets, name)
+}
+
+func (a Accounts) Subscription(name string) string {
+	return AccountName(a.Subscriptions, name)
+}
+
+// AccountName joins the components, which may be account names themselves,
+// into an account name every format accepts: each component starts with a
+// capital letter or a digit and holds only letters, digits and hyphens.
+func AccountName(components ...string) string {
+	names := []string{}
+	for _, component := range components {
+		for _, name := range strings.Split(component, ":") {
+			words := strings.FieldsFunc(name, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			})
+
+			for i, word := range words {
+				runes := []rune(word)
+				runes[0] = unicode.ToUpper(runes[0])
+				words[i] = string(runes)
+			}
+
+			if len(words) > 0 {
+				names = append(names, strings.Join(words, "-"))
+			}
+		}
+	}
+
+	return strings.Join(names, ":")
+}
+
+type Posting struct {
+	Account string
+	Amount  common_types.Money
+}
+
+type Postings []Posting
+
+// Entry is a balanced journal transaction. The ID of the banda transaction, or
+// transfer, it comes from is kept as metadata.
+type Entry struct {
+	ID          uuid.UUID
+	Date        time.Time
+	Cleared     bool
+	Payee       string
+	Description string
+	Postings    Postings
+}
+
+// Journal writes entries in the syntax of a format. Beancount accounts must be
+// opened before they are used, so an open directive dated on the first entry
+// using the account is written ahead of it. Entries are thus expected in date
+// order.
+type Journal struct {
+	w      *bufio.Writer
+	format export_types.Format
+	opened map[string]bool
+}
+
+func NewJournal(w io.Writer, format export_types.Format) *Journal {
+	return &Journal{
+		w:      bufio.NewWriter(w),
+		format: format,
+		opened: map[string]bool{},
+	}
+}
+
+func (j *Journal) Write(entry Entry) error {
+	switch j.format {
+	case export_types.Beancount:
+		return j.writeBeancount(entry)
+	default:
+		return j.writeLedger(entry)
+	}
+}
+
+// Flush writes out what is buffered and reports the first failed write.
+func (j *Journal) Flush() error {
+	return j.w.Flush()
+}
+
+// writeLedger writes the syntax shared by ledger and hledger, which only
+// differ in the preferred date separator.
+func (j *Journal) writeLedger(entry Entry) error {
+	date := entry.Date.Format("2006/01/02")
+	if j.format == export_types.HLedger {
+		date = entry.Date.Format(time.DateOnly)
+	}
+
+	status := ""
+	if entry.Cleared {
+		status = " *"
+	}
+
+	fmt.Fprintf(j.w, "%s%s %s\n", date, status, clean(entry.Description))
+	fmt.Fprintf(j.w, "    ; id: %s\n", entry.ID)
+	if entry.Payee != "" {
+		fmt.Fprintf(j.w, "    ; payee: %s\n", clean(entry.Payee))
+	}
+
+	j.writePostings("    ", entry.Postings)
+
+	// The buffered writer keeps the first error it ran into.
+	_, err := fmt.Fprintln(j.w)
+	return err
+}
+
+func (j *Journal) writeBeancount(entry Entry) error {
+	date := entry.Date.Format(time.DateOnly)
+	for _, posting := range entry.Postings {
+		if !j.opened[posting.Account] {
+			j.opened[posting.Account] = true
+			fmt.Fprintf(j.w, "%s open %s\n\n", date, posting.Account)
+		}
+	}
+
+	flag := "!"
+	if entry.Cleared {
+		flag = "*"
+	}
+
+	fmt.Fprintf(j.w, "%s %s", date, flag)
+	if entry.Payee != "" {
+		fmt.Fprintf(j.w, " %s", quote(entry.Payee))
+	}
+
+	fmt.Fprintf(j.w, " %s\n", quote(entry.Description))
+	fmt.Fprintf(j.w, "  id: %s\n", quote(entry.ID.String()))
+	j.writePostings("  ", entry.Postings)
+
+	_, err := fmt.Fprintln(j.w)
+	return err
+}
+
+// writePostings aligns the amounts of the postings, at least two spaces after
+// the longest account as the formats require.
+func (j *Journal) writePostings(indent string, postings Postings) {
+	width := 0
+	for _, posting := range postings {
+		width = max(width, len(posting.Account))
+	}
+
+	for _, posting := range postings {
+		fmt.Fprintf(j.w, "%s%-*s  %s\n", indent, width, posting.Account, FormatAmount(posting.Amount))
+	}
+}
+
+// FormatAmount writes the minor units as a decimal with the exponent of the
+// currency, followed by the currency as commodity.
+func FormatAmount(money common_types.Money) string {
+	return fmt.Sprintf("%s %s", money.Decimal(), money.Currency)
+}
+
+// clean collapses the whitespace, so a description cannot break the line or,
+// in ledger, start a note with two spaces.
+func clean(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func quote(value string) string {
+	value = strings.ReplaceAll(clean(value), `\`, `\\`)
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
@@ -0,0 +1,15 @@
+package export_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrExportFormatInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "EXPORT_FORMAT_INVALID_ERROR",
+		Message: "Export format is not valid. Please pass ledger, hledger or beancount.",
+	}
+)
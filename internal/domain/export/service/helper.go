@@ -0,0 +1,57 @@
+package export_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	payee_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/specification"
+	subscription_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/specification"
+)
+
+// getAccounts returns the account names by id.
+func (s *ExportServiceImpl) getAccounts(ctx context.Context) (map[uuid.UUID]string, error) {
+	accounts, err := s.accountRepository.List(ctx, common_repository.ListArgs[account_specification.AccountSpecification]{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[uuid.UUID]string{}
+	for _, account := range accounts {
+		names[account.ID] = account.Name
+	}
+
+	return names, nil
+}
+
+// getSubscriptions returns the subscription names by id.
+func (s *ExportServiceImpl) getSubscriptions(ctx context.Context) (map[uuid.UUID]string, error) {
+	subscriptions, err := s.subscriptionRepository.List(ctx, common_repository.ListArgs[subscription_specification.SubscriptionSpecification]{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[uuid.UUID]string{}
+	for _, subscription := range subscriptions {
+		names[subscription.ID] = subscription.Name
+	}
+
+	return names, nil
+}
+
+// getPayees returns the payee names by id.
+func (s *ExportServiceImpl) getPayees(ctx context.Context) (map[uuid.UUID]string, error) {
+	payees, err := s.payeeRepository.List(ctx, common_repository.ListArgs[payee_specification.PayeeSpecification]{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[uuid.UUID]string{}
+	for _, payee := range payees {
+		names[payee.ID] = payee.Name
+	}
+
+	return names, nil
+}
@@ -0,0 +1,44 @@
+package export_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	account_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/repository"
+	export_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/entity"
+	payee_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/repository"
+	subscription_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/repository"
+	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
+)
+
+type ExportService interface {
+	ExportJournal(ctx context.Context, params *ExportJournalParams) (*ExportJournalResult, error)
+}
+
+type ExportServiceImpl struct {
+	logger                 logger.Logger
+	transactionRepository  transaction_repository.TransactionRepository
+	accountRepository      account_repository.AccountRepository
+	subscriptionRepository subscription_repository.SubscriptionRepository
+	payeeRepository        payee_repository.PayeeRepository
+	accounts               export_entity.Accounts
+}
+
+func New(
+	logger logger.Logger,
+	transactionRepository transaction_repository.TransactionRepository,
+	accountRepository account_repository.AccountRepository,
+	subscriptionRepository subscription_repository.SubscriptionRepository,
+	payeeRepository payee_repository.PayeeRepository,
+	accounts export_entity.Accounts,
+) ExportService {
+	return &ExportServiceImpl{
+		logger:                 logger,
+		transactionRepository:  transactionRepository,
+		accountRepository:      accountRepository,
+		subscriptionRepository: subscriptionRepository,
+		payeeRepository:        payeeRepository,
+		accounts:               accounts.WithDefaults(),
+	}
+}
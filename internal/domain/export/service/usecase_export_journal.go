@@ -0,0 +1,161 @@
+package export_service
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	export_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/entity"
+	export_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/errors"
+	export_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/types"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type ExportJournalParams struct {
+	Format export_types.Format
+	Writer io.Writer
+}
+
+type ExportJournalResult struct {
+	Entries int
+}
+
+// ExportJournal streams every transaction, oldest first, into the writer as a
+// journal entry between the asset account of the banda account and the
+// account the money came from or went to. Both legs of a transfer make one
+// entry.
+func (s *ExportServiceImpl) ExportJournal(ctx context.Context, params *ExportJournalParams) (*ExportJournalResult, error) {
+	if params.Format == export_types.NoFormat {
+		return nil, export_errors.ErrExportFormatInvalid
+	}
+
+	accounts, err := s.getAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.getSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payees, err := s.getPayees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	iterator, err := s.transactionRepository.Each(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Sort: common_specification.Sort(
+			common_specification.SortArg{Column: "created_at", Direction: "ASC"},
+			common_specification.SortArg{Column: "id", Direction: "ASC"},
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	journal := export_entity.NewJournal(params.Writer, params.Format)
+	result := &ExportJournalResult{}
+
+	// A transfer is written once both of its legs were read.
+	legs := map[uuid.UUID]transaction_entity.Transaction{}
+	for iterator.Next() {
+		transaction, err := iterator.Current()
+		if err != nil {
+			return nil, err
+		}
+
+		entry := export_entity.Entry{
+			ID:          transaction.ID,
+			Date:        transaction.CreatedAt,
+			Cleared:     transaction.Cleared,
+			Payee:       payees[transaction.PayeeID],
+			Description: transaction.Description,
+			Postings: export_entity.Postings{
+				{
+					Account: s.accounts.Asset(accounts[transaction.AccountID]),
+					Amount:  transaction.SignedAmount(),
+				},
+			},
+		}
+
+		counterpart := export_entity.Posting{
+			Account: s.accounts.Expenses,
+			Amount:  transaction.SignedAmount().Neg(),
+		}
+
+		switch {
+		case transaction.IsTransfer():
+			leg, ok := legs[transaction.TransferID]
+			if !ok {
+				legs[transaction.TransferID] = transaction
+				continue
+			}
+
+			delete(legs, transaction.TransferID)
+
+			entry.ID = transaction.TransferID
+			entry.Cleared = transaction.Cleared && leg.Cleared
+			counterpart.Account = s.accounts.Asset(accounts[leg.AccountID])
+			counterpart.Amount = leg.SignedAmount()
+		case transaction.IsCharge():
+			// Refunds of a charge go back to the subscription account too.
+			counterpart.Account = s.accounts.Subscription(subscriptions[transaction.SubscriptionID])
+		case transaction.SignedAmount().IsPositive():
+			counterpart.Account = s.accounts.Income
+		}
+
+		entry.Postings = append(entry.Postings, counterpart)
+		if err := journal.Write(entry); err != nil {
+			return nil, err
+		}
+
+		result.Entries++
+	}
+
+	// The other leg of these transfers is gone, so they are balanced against
+	// equity to keep the asset balances right.
+	unmatched := []transaction_entity.Transaction{}
+	for _, leg := range legs {
+		unmatched = append(unmatched, leg)
+	}
+
+	slices.SortFunc(unmatched, func(a, b transaction_entity.Transaction) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	for _, leg := range unmatched {
+		if err := journal.Write(export_entity.Entry{
+			ID:          leg.TransferID,
+			Date:        leg.CreatedAt,
+			Cleared:     leg.Cleared,
+			Payee:       payees[leg.PayeeID],
+			Description: leg.Description,
+			Postings: export_entity.Postings{
+				{
+					Account: s.accounts.Asset(accounts[leg.AccountID]),
+					Amount:  leg.SignedAmount(),
+				},
+				{
+					Account: s.accounts.Transfers,
+					Amount:  leg.SignedAmount().Neg(),
+				},
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		result.Entries++
+	}
+
+	if err := journal.Flush(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
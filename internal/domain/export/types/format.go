@@ -0,0 +1,67 @@
+package export_types
+
+import "encoding/json"
+
+// Format is the plain-text accounting syntax a journal is exported in.
+type Format int
+
+const (
+	Ledger Format = iota
+	HLedger
+	Beancount
+)
+
+func (f Format) String() string {
+	switch f {
+	case Ledger:
+		return "ledger"
+	case HLedger:
+		return "hledger"
+	case Beancount:
+		return "beancount"
+	default:
+		return ""
+	}
+}
+
+// Extension is the file extension the tools expect for the format.
+func (f Format) Extension() string {
+	switch f {
+	case Ledger:
+		return ".ledger"
+	case HLedger:
+		return ".journal"
+	case Beancount:
+		return ".beancount"
+	default:
+		return ""
+	}
+}
+
+func (f *Format) UnmarshalJSON(b []byte) error {
+	var val string
+	if err := json.Unmarshal(b, &val); err != nil {
+		return err
+	}
+	*f = GetFormat(val)
+	return nil
+}
+
+func (f *Format) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+func GetFormat(str string) Format {
+	switch str {
+	case "ledger":
+		return Ledger
+	case "hledger":
+		return HLedger
+	case "beancount":
+		return Beancount
+	default:
+		return NoFormat
+	}
+}
+
+var NoFormat Format = -1
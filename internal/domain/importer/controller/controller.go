@@ -0,0 +1,370 @@
+package importer_controller
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	importer_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/service"
+)
+
+type ImporterController interface {
+	Register(*echo.Echo)
+	CreateProfile(c echo.Context) error
+	GetProfile(c echo.Context) error
+	ListProfiles(c echo.Context) error
+	DeleteProfile(c echo.Context) error
+	PreviewCSV(c echo.Context) error
+	ImportCSV(c echo.Context) error
+	PreviewOFX(c echo.Context) error
+	ImportOFX(c echo.Context) error
+	PreviewCamt(c echo.Context) error
+	ImportCamt(c echo.Context) error
+	PreviewJournal(c echo.Context) error
+	ImportJournal(c echo.Context) error
+}
+
+type ImporterControllerImpl struct {
+	logger          logger.Logger
+	importerService importer_service.ImporterService
+}
+
+func (ctl *ImporterControllerImpl) Register(e *echo.Echo) {
+	e.POST("/v1/imports/profiles", ctl.CreateProfile)
+	e.GET("/v1/imports/profiles", ctl.ListProfiles)
+	e.GET("/v1/imports/profiles/:id", ctl.GetProfile)
+	e.DELETE("/v1/imports/profiles/:id", ctl.DeleteProfile)
+	e.POST("/v1/imports/csv/preview", ctl.PreviewCSV)
+	e.POST("/v1/imports/csv", ctl.ImportCSV)
+	e.POST("/v1/imports/ofx/preview", ctl.PreviewOFX)
+	e.POST("/v1/imports/ofx", ctl.ImportOFX)
+	e.POST("/v1/imports/camt/preview", ctl.PreviewCamt)
+	e.POST("/v1/imports/camt", ctl.ImportCamt)
+	e.POST("/v1/imports/journal/preview", ctl.PreviewJournal)
+	e.POST("/v1/imports/journal", ctl.ImportJournal)
+}
+
+func (ctl *ImporterControllerImpl) CreateProfile(c echo.Context) error {
+	requestJSON := &CreateProfileRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.importerService.CreateProfile(c.Request().Context(), &importer_service.CreateProfileParams{
+		Name:              requestJSON.Profile.Name,
+		Delimiter:         requestJSON.Profile.Delimiter,
+		SkipRows:          requestJSON.Profile.SkipRows,
+		DateColumn:        newColumnParam(requestJSON.Profile.DateColumn),
+		DateFormat:        requestJSON.Profile.DateFormat,
+		DescriptionColumn: newColumnParam(requestJSON.Profile.DescriptionColumn),
+		AmountColumn:      newColumnParam(requestJSON.Profile.AmountColumn),
+		DebitColumn:       newColumnParam(requestJSON.Profile.DebitColumn),
+		CreditColumn:      newColumnParam(requestJSON.Profile.CreditColumn),
+		DecimalSeparator:  requestJSON.Profile.DecimalSeparator,
+		ThousandSeparator: requestJSON.Profile.ThousandSeparator,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &CreateProfileResponse{
+		Profile: NewProfileResponse(result.Profile),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (ctl *ImporterControllerImpl) GetProfile(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.importerService.GetProfile(c.Request().Context(), &importer_service.GetProfileParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &GetProfileResponse{
+		Profile: NewProfileResponse(result.Profile),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *ImporterControllerImpl) ListProfiles(c echo.Context) error {
+	params := &importer_service.ListProfilesParams{
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.importerService.ListProfiles(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListProfilesResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Profiles:           NewProfilesResponse(result.Profiles),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *ImporterControllerImpl) DeleteProfile(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	if _, err := ctl.importerService.DeleteProfile(c.Request().Context(), &importer_service.DeleteProfileParams{
+		ID: id,
+	}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// PreviewCSV parses the uploaded statement, sent as the multipart field
+// "file" together with the "profile" and "account" names, without saving it.
+func (ctl *ImporterControllerImpl) PreviewCSV(c echo.Context) error {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := ctl.importerService.PreviewCSV(c.Request().Context(), &importer_service.PreviewCSVParams{
+		ProfileName: c.FormValue("profile"),
+		AccountName: c.FormValue("account"),
+		CSV:         file,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewPreviewResponse(result.Rows, nil, result.Errors, result.Account.Currency))
+}
+
+func (ctl *ImporterControllerImpl) ImportCSV(c echo.Context) error {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := ctl.importerService.ImportCSV(c.Request().Context(), &importer_service.ImportCSVParams{
+		ProfileName: c.FormValue("profile"),
+		AccountName: c.FormValue("account"),
+		CSV:         file,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &ImportResponse{
+		Imported:       len(result.TransactionIDs),
+		TransactionIDs: result.TransactionIDs,
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// PreviewOFX parses the uploaded OFX download, sent as the multipart field
+// "file" together with the "account" name, without saving it.
+func (ctl *ImporterControllerImpl) PreviewOFX(c echo.Context) error {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := ctl.importerService.PreviewOFX(c.Request().Context(), &importer_service.PreviewOFXParams{
+		AccountName: c.FormValue("account"),
+		OFX:         file,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewPreviewResponse(result.Rows, result.Skipped, result.Errors, result.Account.Currency))
+}
+
+func (ctl *ImporterControllerImpl) ImportOFX(c echo.Context) error {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := ctl.importerService.ImportOFX(c.Request().Context(), &importer_service.ImportOFXParams{
+		AccountName: c.FormValue("account"),
+		OFX:         file,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &ImportResponse{
+		Imported:       len(result.TransactionIDs),
+		Skipped:        result.Skipped,
+		TransactionIDs: result.TransactionIDs,
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// PreviewCamt parses the uploaded camt.053 or camt.052 document, sent as the
+// multipart field "file" together with the "account" name, without saving it.
+func (ctl *ImporterControllerImpl) PreviewCamt(c echo.Context) error {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := ctl.importerService.PreviewCamt(c.Request().Context(), &importer_service.PreviewCamtParams{
+		AccountName: c.FormValue("account"),
+		Camt:        file,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewPreviewResponse(result.Rows, result.Skipped, result.Errors, result.Account.Currency))
+}
+
+// ImportCamt imports the entries that parse and answers with the errors of
+// those that do not.
+func (ctl *ImporterControllerImpl) ImportCamt(c echo.Context) error {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := ctl.importerService.ImportCamt(c.Request().Context(), &importer_service.ImportCamtParams{
+		AccountName: c.FormValue("account"),
+		Camt:        file,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &ImportResponse{
+		Imported:       len(result.TransactionIDs),
+		Skipped:        result.Skipped,
+		TransactionIDs: result.TransactionIDs,
+		Errors:         NewRowErrorsResponse(result.Errors),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+// PreviewJournal parses the uploaded ledger, hledger or beancount journal,
+// sent as the multipart field "file", without saving it. The accounts are
+// taken from the postings.
+func (ctl *ImporterControllerImpl) PreviewJournal(c echo.Context) error {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := ctl.importerService.PreviewJournal(c.Request().Context(), &importer_service.PreviewJournalParams{
+		Journal: file,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewJournalPreviewResponse(result.Bookings, result.Errors, result.Unsupported))
+}
+
+func (ctl *ImporterControllerImpl) ImportJournal(c echo.Context) error {
+	header, err := c.FormFile("file")
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := ctl.importerService.ImportJournal(c.Request().Context(), &importer_service.ImportJournalParams{
+		Journal: file,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &ImportResponse{
+		Imported:       len(result.TransactionIDs),
+		TransactionIDs: result.TransactionIDs,
+		Unsupported:    NewRowErrorsResponse(result.Unsupported),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func New(logger logger.Logger, importerService importer_service.ImporterService) ImporterController {
+	return &ImporterControllerImpl{
+		logger:          logger,
+		importerService: importerService,
+	}
+}
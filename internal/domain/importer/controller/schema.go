@@ -0,0 +1,231 @@
+package importer_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+)
+
+type ProfileResponse struct {
+	ID                uuid.UUID `json:"id"`
+	Name              string    `json:"name"`
+	Delimiter         string    `json:"delimiter"`
+	SkipRows          int       `json:"skip_rows"`
+	DateColumn        int       `json:"date_column"`
+	DateFormat        string    `json:"date_format"`
+	DescriptionColumn int       `json:"description_column"`
+	AmountColumn      *int      `json:"amount_column"`
+	DebitColumn       *int      `json:"debit_column"`
+	CreditColumn      *int      `json:"credit_column"`
+	DecimalSeparator  string    `json:"decimal_separator"`
+	ThousandSeparator string    `json:"thousand_separator"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type ProfilesResponse []ProfileResponse
+
+type ProfileRequest struct {
+	Name              string `json:"name"`
+	Delimiter         string `json:"delimiter"`
+	SkipRows          int    `json:"skip_rows"`
+	DateColumn        *int   `json:"date_column"`
+	DateFormat        string `json:"date_format"`
+	DescriptionColumn *int   `json:"description_column"`
+	AmountColumn      *int   `json:"amount_column"`
+	DebitColumn       *int   `json:"debit_column"`
+	CreditColumn      *int   `json:"credit_column"`
+	DecimalSeparator  string `json:"decimal_separator"`
+	ThousandSeparator string `json:"thousand_separator"`
+}
+
+type CreateProfileRequest struct {
+	Profile ProfileRequest `json:"profile"`
+}
+
+type CreateProfileResponse struct {
+	Profile ProfileResponse `json:"profile"`
+}
+
+type GetProfileResponse struct {
+	Profile ProfileResponse `json:"profile"`
+}
+
+type ListProfilesResponse struct {
+	common_schema.PaginationResponse
+	Profiles ProfilesResponse `json:"profiles"`
+}
+
+type RowResponse struct {
+	Line        int        `json:"line"`
+	ExternalID  string     `json:"external_id,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ValueDate   *time.Time `json:"value_date,omitempty"`
+	Description string     `json:"description"`
+	Amount      int64      `json:"amount"`
+	Currency    string     `json:"currency"`
+	Direction   string     `json:"direction"`
+}
+
+type RowErrorResponse struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+type PreviewResponse struct {
+	Rows    []RowResponse      `json:"rows"`
+	Skipped []RowResponse      `json:"skipped"`
+	Errors  []RowErrorResponse `json:"errors"`
+}
+
+type ImportResponse struct {
+	Imported       int                `json:"imported"`
+	Skipped        int                `json:"skipped"`
+	TransactionIDs []uuid.UUID        `json:"transaction_ids"`
+	Errors         []RowErrorResponse `json:"errors,omitempty"`
+	Unsupported    []RowErrorResponse `json:"unsupported,omitempty"`
+}
+
+type BookingResponse struct {
+	Line        int       `json:"line"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+	Account     string    `json:"account"`
+	Destination string    `json:"destination,omitempty"`
+	Amount      int64     `json:"amount"`
+	Currency    string    `json:"currency"`
+	Direction   string    `json:"direction"`
+}
+
+type JournalPreviewResponse struct {
+	Bookings    []BookingResponse  `json:"bookings"`
+	Errors      []RowErrorResponse `json:"errors"`
+	Unsupported []RowErrorResponse `json:"unsupported"`
+}
+
+func NewProfileResponse(profile importer_entity.Profile) ProfileResponse {
+	return ProfileResponse{
+		ID:                profile.ID,
+		Name:              profile.Name,
+		Delimiter:         profile.Delimiter,
+		SkipRows:          profile.SkipRows,
+		DateColumn:        profile.DateColumn,
+		DateFormat:        profile.DateFormat,
+		DescriptionColumn: profile.DescriptionColumn,
+		AmountColumn:      newColumnResponse(profile.AmountColumn),
+		DebitColumn:       newColumnResponse(profile.DebitColumn),
+		CreditColumn:      newColumnResponse(profile.CreditColumn),
+		DecimalSeparator:  profile.DecimalSeparator,
+		ThousandSeparator: profile.ThousandSeparator,
+		CreatedAt:         profile.CreatedAt,
+		UpdatedAt:         profile.UpdatedAt,
+	}
+}
+
+func NewProfilesResponse(profiles importer_entity.Profiles) ProfilesResponse {
+	profilesResponse := ProfilesResponse{}
+
+	for _, p := range profiles {
+		profilesResponse = append(profilesResponse, NewProfileResponse(p))
+	}
+
+	return profilesResponse
+}
+
+func NewRowResponse(row importer_entity.Row, currency string) RowResponse {
+	return RowResponse{
+		Line:        row.Line,
+		ExternalID:  row.ExternalID,
+		CreatedAt:   row.CreatedAt,
+		ValueDate:   newValueDateResponse(row.ValueDate),
+		Description: row.Description,
+		Amount:      row.Amount,
+		Currency:    currency,
+		Direction:   row.Direction.String(),
+	}
+}
+
+func NewPreviewResponse(rows importer_entity.Rows, skipped importer_entity.Rows, rowErrors importer_entity.RowErrors, currency string) *PreviewResponse {
+	response := &PreviewResponse{
+		Rows:    []RowResponse{},
+		Skipped: []RowResponse{},
+		Errors:  NewRowErrorsResponse(rowErrors),
+	}
+
+	for _, r := range rows {
+		response.Rows = append(response.Rows, NewRowResponse(r, currency))
+	}
+
+	for _, r := range skipped {
+		response.Skipped = append(response.Skipped, NewRowResponse(r, currency))
+	}
+
+	return response
+}
+
+func NewBookingResponse(booking importer_entity.Booking) BookingResponse {
+	return BookingResponse{
+		Line:        booking.Line,
+		CreatedAt:   booking.CreatedAt,
+		Description: booking.Description,
+		Account:     booking.Account,
+		Destination: booking.Destination,
+		Amount:      booking.Amount.Amount,
+		Currency:    booking.Amount.Currency,
+		Direction:   booking.Direction.String(),
+	}
+}
+
+func NewJournalPreviewResponse(bookings importer_entity.Bookings, rowErrors importer_entity.RowErrors, unsupported importer_entity.RowErrors) *JournalPreviewResponse {
+	response := &JournalPreviewResponse{
+		Bookings:    []BookingResponse{},
+		Errors:      NewRowErrorsResponse(rowErrors),
+		Unsupported: NewRowErrorsResponse(unsupported),
+	}
+
+	for _, b := range bookings {
+		response.Bookings = append(response.Bookings, NewBookingResponse(b))
+	}
+
+	return response
+}
+
+func NewRowErrorsResponse(rowErrors importer_entity.RowErrors) []RowErrorResponse {
+	rowErrorsResponse := []RowErrorResponse{}
+
+	for _, e := range rowErrors {
+		rowErrorsResponse = append(rowErrorsResponse, RowErrorResponse{
+			Line:   e.Line,
+			Reason: e.Reason,
+		})
+	}
+
+	return rowErrorsResponse
+}
+
+func newValueDateResponse(valueDate time.Time) *time.Time {
+	if valueDate.IsZero() {
+		return nil
+	}
+
+	return &valueDate
+}
+
+func newColumnResponse(column int) *int {
+	if column == importer_entity.NoColumn {
+		return nil
+	}
+
+	return &column
+}
+
+func newColumnParam(column *int) int {
+	if column == nil {
+		return importer_entity.NoColumn
+	}
+
+	return *column
+}
@@ -0,0 +1,290 @@
+package importer_entity
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+// The camt elements are matched by their local name only, so every version of
+// the camt.052 and camt.053 schemas is read the same way.
+
+type camtAccount struct {
+	Currency string `xml:"Ccy"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+// camtStatus is the plain code of the older versions or the Cd element of the
+// newer ones.
+type camtStatus struct {
+	Value string `xml:",chardata"`
+	Code  string `xml:"Cd"`
+}
+
+// camtParty holds the name directly in the older versions and inside Pty in
+// the newer ones.
+type camtParty struct {
+	Name      string `xml:"Nm"`
+	PartyName string `xml:"Pty>Nm"`
+}
+
+type camtTransaction struct {
+	Debtor             camtParty `xml:"RltdPties>Dbtr"`
+	Creditor           camtParty `xml:"RltdPties>Cdtr"`
+	Unstructured       []string  `xml:"RmtInf>Ustrd"`
+	CreditorReferences []string  `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+	ServicerReference  string    `xml:"Refs>AcctSvcrRef"`
+}
+
+type camtEntry struct {
+	Reference         string            `xml:"NtryRef"`
+	Amount            camtAmount        `xml:"Amt"`
+	Indicator         string            `xml:"CdtDbtInd"`
+	Reversal          bool              `xml:"RvslInd"`
+	Status            camtStatus        `xml:"Sts"`
+	BookingDate       camtDate          `xml:"BookgDt"`
+	ValueDate         camtDate          `xml:"ValDt"`
+	ServicerReference string            `xml:"AcctSvcrRef"`
+	Transactions      []camtTransaction `xml:"NtryDtls>TxDtls"`
+	Information       string            `xml:"AddtlNtryInf"`
+}
+
+// ParseCamt parses the Ntry entries of an ISO 20022 camt.053 statement or
+// camt.052 intraday report and returns them with the currency of the account.
+// Entries that do not parse, are not booked yet or are in another currency are
+// reported with the line they start on and left out. Amounts are converted to
+// minor units with the exponent of the account currency.
+func ParseCamt(r io.Reader, exponent int) (string, Rows, RowErrors, error) {
+	decoder := xml.NewDecoder(r)
+
+	found := false
+	currency := ""
+	rows := Rows{}
+	rowErrors := RowErrors{}
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", nil, nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "BkToCstmrStmt", "BkToCstmrAcctRpt":
+			found = true
+		case "Acct":
+			account := camtAccount{}
+			if err := decoder.DecodeElement(&account, &start); err != nil {
+				return "", nil, nil, err
+			}
+
+			if currency == "" {
+				currency = strings.ToUpper(strings.TrimSpace(account.Currency))
+			}
+		case "Ntry":
+			line, _ := decoder.InputPos()
+
+			entry := camtEntry{}
+			if err := decoder.DecodeElement(&entry, &start); err != nil {
+				return "", nil, nil, err
+			}
+
+			if currency == "" {
+				currency = strings.ToUpper(strings.TrimSpace(entry.Amount.Currency))
+			}
+
+			row, err := parseCamtEntry(entry, currency, exponent)
+			if err != nil {
+				rowErrors = append(rowErrors, RowError{
+					Line:   line,
+					Reason: err.Error(),
+				})
+				continue
+			}
+
+			row.Line = line
+			rows = append(rows, row)
+		}
+	}
+
+	if !found {
+		return "", nil, nil, errors.New("missing BkToCstmrStmt or BkToCstmrAcctRpt element")
+	}
+
+	return currency, rows, rowErrors, nil
+}
+
+func parseCamtEntry(entry camtEntry, currency string, exponent int) (Row, error) {
+	status := strings.TrimSpace(entry.Status.Code)
+	if status == "" {
+		status = strings.TrimSpace(entry.Status.Value)
+	}
+
+	if status != "" && status != "BOOK" {
+		return Row{}, fmt.Errorf("entry is not booked, status %s", status)
+	}
+
+	row := Row{
+		ExternalID: camtReference(entry),
+	}
+
+	if entry.BookingDate == (camtDate{}) {
+		return Row{}, errors.New("missing booking date")
+	}
+
+	createdAt, err := parseCamtDate(entry.BookingDate)
+	if err != nil {
+		return Row{}, err
+	}
+
+	row.CreatedAt = createdAt
+
+	if entry.ValueDate != (camtDate{}) {
+		row.ValueDate, err = parseCamtDate(entry.ValueDate)
+		if err != nil {
+			return Row{}, err
+		}
+	}
+
+	if entryCurrency := strings.ToUpper(strings.TrimSpace(entry.Amount.Currency)); entryCurrency != currency {
+		return Row{}, fmt.Errorf("amount in %s, statement in %s", entryCurrency, currency)
+	}
+
+	row.Amount, err = parseAmount(entry.Amount.Value, ".", "", exponent)
+	if err != nil {
+		return Row{}, err
+	}
+
+	if row.Amount <= 0 {
+		return Row{}, fmt.Errorf("invalid amount %q", entry.Amount.Value)
+	}
+
+	switch strings.TrimSpace(entry.Indicator) {
+	case "CRDT":
+		row.Direction = transaction_types.Income
+	case "DBIT":
+		row.Direction = transaction_types.Expense
+	default:
+		return Row{}, fmt.Errorf("invalid credit debit indicator %q", entry.Indicator)
+	}
+
+	row.Description = camtDescription(entry, row.Direction)
+	if row.Description == "" {
+		return Row{}, errors.New("empty description")
+	}
+
+	// A reversal is booked on the opposite side of the entry it reverses.
+	if entry.Reversal {
+		row.Direction = transaction_types.Income
+		if entry.Indicator == "CRDT" {
+			row.Direction = transaction_types.Expense
+		}
+	}
+
+	return row, nil
+}
+
+// camtReference prefers the entry reference and falls back to the reference
+// the bank gave the entry or its only transaction.
+func camtReference(entry camtEntry) string {
+	switch {
+	case strings.TrimSpace(entry.Reference) != "":
+		return strings.TrimSpace(entry.Reference)
+	case strings.TrimSpace(entry.ServicerReference) != "":
+		return strings.TrimSpace(entry.ServicerReference)
+	case len(entry.Transactions) == 1:
+		return strings.TrimSpace(entry.Transactions[0].ServicerReference)
+	default:
+		return ""
+	}
+}
+
+// camtDescription joins the counterparty names and the remittance information
+// of the transactions in the entry, the additional entry information standing
+// in for missing remittance information. The counterparty is the debtor of
+// incoming money and the creditor of outgoing money.
+func camtDescription(entry camtEntry, direction transaction_types.Direction) string {
+	parts := []string{}
+	add := func(value string) {
+		value = strings.Join(strings.Fields(value), " ")
+		if value == "" {
+			return
+		}
+
+		for _, part := range parts {
+			if strings.Contains(part, value) {
+				return
+			}
+		}
+
+		parts = append(parts, value)
+	}
+
+	for _, transaction := range entry.Transactions {
+		counterparty := transaction.Creditor
+		if direction == transaction_types.Income {
+			counterparty = transaction.Debtor
+		}
+
+		add(counterparty.Name)
+		add(counterparty.PartyName)
+	}
+
+	counterparties := len(parts)
+	for _, transaction := range entry.Transactions {
+		add(strings.Join(transaction.Unstructured, " "))
+
+		if len(transaction.Unstructured) == 0 {
+			add(strings.Join(transaction.CreditorReferences, " "))
+		}
+	}
+
+	if len(parts) == counterparties {
+		add(entry.Information)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// parseCamtDate reads an ISO date or datetime. Datetimes without an offset are
+// in UTC.
+func parseCamtDate(date camtDate) (time.Time, error) {
+	if value := strings.TrimSpace(date.Date); value != "" {
+		t, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", value)
+		}
+
+		return t, nil
+	}
+
+	value := strings.TrimSpace(date.DateTime)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
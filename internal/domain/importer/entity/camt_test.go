@@ -0,0 +1,183 @@
+package importer_entity
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+func TestParseCamtDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		date    camtDate
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "date", date: camtDate{Date: "2024-03-01"}, want: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "datetime with offset", date: camtDate{DateTime: "2024-03-01T10:00:00+07:00"}, want: time.Date(2024, 3, 1, 3, 0, 0, 0, time.UTC)},
+		{name: "datetime without offset", date: camtDate{DateTime: "2024-03-01T10:00:00.5"}, want: time.Date(2024, 3, 1, 10, 0, 0, 500000000, time.UTC)},
+		{name: "date wins over datetime", date: camtDate{Date: "2024-03-01", DateTime: "2024-03-02T10:00:00"}, want: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "invalid date", date: camtDate{Date: "01/03/2024"}, wantErr: true},
+		{name: "invalid datetime", date: camtDate{DateTime: "yesterday"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCamtDate(tt.date)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCamtDate(%+v) error = %v, wantErr %v", tt.date, err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("parseCamtDate(%+v) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+const camt053 = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Acct><Ccy>eur</Ccy></Acct>
+      <Ntry>
+        <NtryRef>E1</NtryRef>
+        <Amt Ccy="EUR">12.50</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2024-03-01</Dt></BookgDt>
+        <ValDt><Dt>2024-03-02</Dt></ValDt>
+        <NtryDtls><TxDtls>
+          <RltdPties><Cdtr><Pty><Nm>Bakery</Nm></Pty></Cdtr></RltdPties>
+          <RmtInf><Ustrd>Invoice 42</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">100.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts>BOOK</Sts>
+        <BookgDt><DtTm>2024-03-03T09:30:00</DtTm></BookgDt>
+        <AcctSvcrRef>S2</AcctSvcrRef>
+        <NtryDtls><TxDtls>
+          <RltdPties><Dbtr><Nm>Employer</Nm></Dbtr></RltdPties>
+        </TxDtls></NtryDtls>
+        <AddtlNtryInf>Salary March</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <NtryRef>E3</NtryRef>
+        <Amt Ccy="EUR">12.50</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <RvslInd>true</RvslInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2024-03-04</Dt></BookgDt>
+        <AddtlNtryInf>Card payment reversed</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <NtryRef>E4</NtryRef>
+        <Amt Ccy="EUR">5.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>PDNG</Cd></Sts>
+        <BookgDt><Dt>2024-03-05</Dt></BookgDt>
+        <AddtlNtryInf>Pending</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <NtryRef>E5</NtryRef>
+        <Amt Ccy="USD">5.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <BookgDt><Dt>2024-03-05</Dt></BookgDt>
+        <AddtlNtryInf>Foreign</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <NtryRef>E6</NtryRef>
+        <Amt Ccy="EUR">5.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <AddtlNtryInf>Undated</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <NtryRef>E7</NtryRef>
+        <Amt Ccy="EUR">5.00</Amt>
+        <CdtDbtInd>XXXX</CdtDbtInd>
+        <BookgDt><Dt>2024-03-05</Dt></BookgDt>
+        <AddtlNtryInf>Sideways</AddtlNtryInf>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>
+`
+
+func TestParseCamt(t *testing.T) {
+	tests := []struct {
+		name         string
+		document     string
+		wantCurrency string
+		wantRows     Rows
+		wantFails    RowErrors
+		wantErr      bool
+	}{
+		{
+			name:         "camt.053 statement",
+			document:     camt053,
+			wantCurrency: "EUR",
+			wantRows: Rows{
+				{Line: 6, ExternalID: "E1", CreatedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), ValueDate: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), Description: "Bakery Invoice 42", Amount: 1250, Direction: transaction_types.Expense},
+				{Line: 18, ExternalID: "S2", CreatedAt: time.Date(2024, 3, 3, 9, 30, 0, 0, time.UTC), Description: "Employer Salary March", Amount: 10000, Direction: transaction_types.Income},
+				{Line: 29, ExternalID: "E3", CreatedAt: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), Description: "Card payment reversed", Amount: 1250, Direction: transaction_types.Expense},
+			},
+			wantFails: RowErrors{
+				{Line: 38, Reason: "entry is not booked, status PDNG"},
+				{Line: 46, Reason: "amount in USD, statement in EUR"},
+				{Line: 53, Reason: "missing booking date"},
+				{Line: 59, Reason: `invalid credit debit indicator "XXXX"`},
+			},
+		},
+		{
+			name: "camt.052 report takes the currency of the first entry",
+			document: `<Document><BkToCstmrAcctRpt><Rpt>
+<Ntry><NtryRef>R1</NtryRef><Amt Ccy="idr">15000</Amt><CdtDbtInd>DBIT</CdtDbtInd><BookgDt><Dt>2024-03-01</Dt></BookgDt><AddtlNtryInf>Parking</AddtlNtryInf></Ntry>
+</Rpt></BkToCstmrAcctRpt></Document>`,
+			wantCurrency: "IDR",
+			wantRows: Rows{
+				{Line: 2, ExternalID: "R1", CreatedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Description: "Parking", Amount: 1500000, Direction: transaction_types.Expense},
+			},
+			wantFails: RowErrors{},
+		},
+		{
+			name:     "not a camt document",
+			document: `<Document><Other/></Document>`,
+			wantErr:  true,
+		},
+		{
+			name:     "malformed XML",
+			document: `<Document><BkToCstmrStmt>`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currency, rows, fails, err := ParseCamt(strings.NewReader(tt.document), 2)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCamt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if currency != tt.wantCurrency {
+				t.Errorf("ParseCamt() currency = %q, want %q", currency, tt.wantCurrency)
+			}
+
+			if !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Errorf("ParseCamt() rows = %+v, want %+v", rows, tt.wantRows)
+			}
+
+			if !reflect.DeepEqual(fails, tt.wantFails) {
+				t.Errorf("ParseCamt() errors = %+v, want %+v", fails, tt.wantFails)
+			}
+		})
+	}
+}
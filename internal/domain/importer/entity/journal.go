@@ -0,0 +1,541 @@
+package importer_entity
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+// JournalPosting moves an amount into, or out of when negative, an account of
+// a plain-text accounting journal.
+type JournalPosting struct {
+	Line    int
+	Account string
+	Amount  common_types.Money
+}
+
+type JournalPostings []JournalPosting
+
+// JournalEntry is a dated transaction of a ledger, hledger or beancount
+// journal with balanced postings.
+type JournalEntry struct {
+	Line        int
+	Date        time.Time
+	Description string
+	Postings    JournalPostings
+}
+
+type JournalEntries []JournalEntry
+
+// Booking is a journal entry resolved against the banda accounts: an income or
+// expense of Account, or a transfer from Account to Destination.
+type Booking struct {
+	Line        int
+	CreatedAt   time.Time
+	Description string
+	Account     string
+	Destination string
+	Amount      common_types.Money
+	Direction   transaction_types.Direction
+}
+
+type Bookings []Booking
+
+func (b Booking) IsTransfer() bool {
+	return b.Destination != ""
+}
+
+var (
+	journalDateLayouts = []string{"2006-1-2", "2006/1/2", "2006.1.2"}
+
+	// beancountDirectives follow a date like transactions do.
+	beancountDirectives = []string{"open", "close", "balance", "pad", "price", "note", "document", "event", "query", "custom", "commodity"}
+
+	// beancountMetadata is a key value line below a beancount transaction.
+	beancountMetadata = regexp.MustCompile(`^[a-z][A-Za-z0-9_-]*:(\s|$)`)
+
+	commoditySymbols = map[string]string{
+		"$":  "USD",
+		"€":  "EUR",
+		"£":  "GBP",
+		"¥":  "JPY",
+		"Rp": "IDR",
+	}
+)
+
+// ParseJournal parses the subset shared by ledger, hledger and beancount
+// journals: dated transactions with a status, payee and description, postings
+// with an amount and commodity, at most one of them left to balance the
+// others, and comments. Prices, costs and balance assertions on postings are
+// ignored. Entries that do not parse are reported as errors, any other
+// directive, such as open, price or include, is reported as unsupported
+// together with the lines below it.
+func ParseJournal(r io.Reader) (JournalEntries, RowErrors, RowErrors, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	entries := JournalEntries{}
+	rowErrors := RowErrors{}
+	unsupported := RowErrors{}
+
+	var entry *JournalEntry
+	failed := false
+	skipping := false
+	commented := false
+
+	finish := func() {
+		if entry != nil && !failed {
+			if err := entry.balance(); err != nil {
+				rowErrors = append(rowErrors, RowError{
+					Line:   entry.Line,
+					Reason: err.Error(),
+				})
+			} else {
+				entries = append(entries, *entry)
+			}
+		}
+
+		entry, failed = nil, false
+	}
+
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		trimmed := strings.TrimSpace(line)
+
+		if commented {
+			commented = !strings.HasPrefix(trimmed, "end comment") && !strings.HasPrefix(trimmed, "end test")
+			continue
+		}
+
+		if trimmed == "" {
+			finish()
+			skipping = false
+			continue
+		}
+
+		if line[0] == ' ' || line[0] == '\t' {
+			switch {
+			case strings.HasPrefix(trimmed, ";") || strings.HasPrefix(trimmed, "#"):
+			case entry != nil:
+				if failed || beancountMetadata.MatchString(trimmed) {
+					continue
+				}
+
+				posting, err := parseJournalPosting(trimmed)
+				if err != nil {
+					rowErrors = append(rowErrors, RowError{
+						Line:   n,
+						Reason: err.Error(),
+					})
+					failed = true
+					continue
+				}
+
+				posting.Line = n
+				entry.Postings = append(entry.Postings, posting)
+			case skipping:
+			default:
+				rowErrors = append(rowErrors, RowError{
+					Line:   n,
+					Reason: "posting outside of a transaction",
+				})
+			}
+
+			continue
+		}
+
+		finish()
+		skipping = false
+
+		if strings.ContainsRune(";#%|*", rune(line[0])) {
+			continue
+		}
+
+		keyword := strings.Fields(line)[0]
+		if keyword == "comment" || keyword == "test" {
+			commented = true
+			continue
+		}
+
+		if !unicode.IsDigit(rune(line[0])) {
+			unsupported = append(unsupported, RowError{
+				Line:   n,
+				Reason: fmt.Sprintf("unsupported directive %q", keyword),
+			})
+			skipping = true
+			continue
+		}
+
+		header, directive, err := parseJournalHeader(line)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{
+				Line:   n,
+				Reason: err.Error(),
+			})
+			skipping = true
+			continue
+		}
+
+		if directive != "" {
+			unsupported = append(unsupported, RowError{
+				Line:   n,
+				Reason: fmt.Sprintf("unsupported directive %q", directive),
+			})
+			skipping = true
+			continue
+		}
+
+		header.Line = n
+		entry = &header
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	finish()
+
+	return entries, rowErrors, unsupported, nil
+}
+
+// parseJournalHeader reads the first line of a transaction. The directive is
+// set instead when the line is a dated beancount directive.
+func parseJournalHeader(line string) (JournalEntry, string, error) {
+	value, rest, _ := strings.Cut(line, " ")
+	rest = strings.TrimSpace(rest)
+
+	// ledger and hledger may follow the date with a secondary one.
+	value, _, _ = strings.Cut(value, "=")
+
+	date, err := parseJournalDate(value)
+	if err != nil {
+		return JournalEntry{}, "", err
+	}
+
+	keyword, _, _ := strings.Cut(rest, " ")
+	for _, directive := range beancountDirectives {
+		if keyword == directive {
+			return JournalEntry{}, directive, nil
+		}
+	}
+
+	switch {
+	case keyword == "txn":
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, "txn"))
+	case strings.HasPrefix(rest, "*") || strings.HasPrefix(rest, "!"):
+		rest = strings.TrimSpace(rest[1:])
+	}
+
+	if strings.HasPrefix(rest, "(") {
+		if i := strings.Index(rest, ")"); i >= 0 {
+			rest = strings.TrimSpace(rest[i+1:])
+		}
+	}
+
+	entry := JournalEntry{
+		Date: date,
+	}
+
+	if strings.HasPrefix(rest, `"`) {
+		// beancount: an optional payee and the narration, then tags and links.
+		parts := []string{}
+		for _, value := range quoted(rest) {
+			if value = strings.Join(strings.Fields(value), " "); value != "" {
+				parts = append(parts, value)
+			}
+		}
+
+		entry.Description = strings.Join(parts, " ")
+	} else {
+		// ledger and hledger: the description up to the note, where hledger
+		// tells the payee apart from the note with a bar.
+		rest, _, _ = strings.Cut(rest, ";")
+		entry.Description = strings.Join(strings.Fields(strings.ReplaceAll(rest, "|", " ")), " ")
+	}
+
+	if entry.Description == "" {
+		return JournalEntry{}, "", errors.New("empty description")
+	}
+
+	return entry, "", nil
+}
+
+func parseJournalDate(value string) (time.Time, error) {
+	for _, layout := range journalDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// quoted returns the double quoted strings of the value, unescaping \" and \\.
+func quoted(value string) []string {
+	values := []string{}
+
+	var current *strings.Builder
+	escaped := false
+	for _, r := range value {
+		switch {
+		case current == nil:
+			if r == '"' {
+				current = &strings.Builder{}
+			}
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			values = append(values, current.String())
+			current = nil
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	return values
+}
+
+// parseJournalPosting reads an account followed by an optional amount. ledger
+// and hledger account names may hold single spaces, so the amount is separated
+// by two spaces or a tab there, while beancount names never hold one.
+func parseJournalPosting(line string) (JournalPosting, error) {
+	if strings.HasPrefix(line, "*") || strings.HasPrefix(line, "!") {
+		line = strings.TrimSpace(line[1:])
+	}
+
+	line, _, _ = strings.Cut(line, ";")
+	line = strings.TrimSpace(line)
+
+	account, value := line, ""
+	if i := separator(line); i >= 0 {
+		account, value = line[:i], line[i:]
+	} else if name, rest, ok := strings.Cut(line, " "); ok && strings.IndexFunc(rest, unicode.IsDigit) >= 0 {
+		account, value = name, rest
+	}
+
+	account = strings.TrimSpace(account)
+	if strings.HasPrefix(account, "(") || strings.HasPrefix(account, "[") {
+		return JournalPosting{}, fmt.Errorf("virtual posting to %s is not supported", account)
+	}
+
+	posting := JournalPosting{
+		Account: account,
+	}
+
+	// Balance assertions, prices and costs do not change the amount posted.
+	value, _, _ = strings.Cut(value, "=")
+	value, _, _ = strings.Cut(value, "@")
+	value, _, _ = strings.Cut(value, "{")
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		return posting, nil
+	}
+
+	amount, err := parseCommodityAmount(value)
+	if err != nil {
+		return JournalPosting{}, err
+	}
+
+	posting.Amount = amount
+	return posting, nil
+}
+
+// separator returns where the first tab or double space is, -1 when there is
+// none.
+func separator(line string) int {
+	tab, spaces := strings.Index(line, "\t"), strings.Index(line, "  ")
+	if tab < 0 || (spaces >= 0 && spaces < tab) {
+		return spaces
+	}
+
+	return tab
+}
+
+// parseCommodityAmount reads an amount with its commodity written before or
+// after the number, like -12.50 USD, USD -12.50 or $-12.50. Commas are taken as
+// thousand separators.
+func parseCommodityAmount(value string) (common_types.Money, error) {
+	start := strings.IndexFunc(value, unicode.IsDigit)
+	if start < 0 {
+		return common_types.Money{}, fmt.Errorf("invalid amount %q", value)
+	}
+
+	end := len(value)
+	if i := strings.IndexFunc(value[start:], func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.' && r != ','
+	}); i >= 0 {
+		end = start + i
+	}
+
+	prefix, number, suffix := value[:start], value[start:end], strings.TrimSpace(value[end:])
+
+	negative := strings.Contains(prefix, "-")
+	prefix = strings.TrimSpace(strings.NewReplacer("-", "", "+", "").Replace(prefix))
+	if negative {
+		number = "-" + number
+	}
+
+	commodity := prefix
+	if suffix != "" {
+		if commodity != "" {
+			return common_types.Money{}, fmt.Errorf("invalid amount %q", value)
+		}
+
+		commodity = suffix
+	}
+
+	commodity = strings.Trim(commodity, `"`)
+	if symbol, ok := commoditySymbols[commodity]; ok {
+		commodity = symbol
+	}
+
+	if commodity == "" {
+		return common_types.Money{}, fmt.Errorf("missing commodity in amount %q", value)
+	}
+
+	amount, err := parseAmount(number, ".", ",", common_types.CurrencyExponent(commodity))
+	if err != nil {
+		return common_types.Money{}, err
+	}
+
+	return common_types.NewMoney(amount, strings.ToUpper(commodity)), nil
+}
+
+// balance fills in the posting left without an amount and checks that the
+// postings of a single commodity cancel out.
+func (e *JournalEntry) balance() error {
+	if len(e.Postings) == 0 {
+		return errors.New("transaction without postings")
+	}
+
+	sums := map[string]int64{}
+	elided := -1
+	for i, posting := range e.Postings {
+		if posting.Amount.Currency == "" {
+			if elided >= 0 {
+				return errors.New("more than one posting without an amount")
+			}
+
+			elided = i
+			continue
+		}
+
+		sums[posting.Amount.Currency] += posting.Amount.Amount
+	}
+
+	if elided >= 0 {
+		if len(sums) != 1 {
+			return errors.New("posting without an amount in a transaction of several commodities")
+		}
+
+		for currency, sum := range sums {
+			e.Postings[elided].Amount = common_types.NewMoney(-sum, currency)
+		}
+
+		return nil
+	}
+
+	// Postings in several commodities balance through prices, which are not
+	// kept, so only single commodity entries are checked.
+	if len(sums) == 1 {
+		for _, sum := range sums {
+			if sum != 0 {
+				return errors.New("transaction does not balance")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Book resolves the entry against the banda accounts. A posting belongs to a
+// banda account when the last component of its account name is the name of
+// the banda account, ignoring case, spaces and punctuation, so Assets:BCA-Main
+// matches the account named "bca main". An entry must post to one banda
+// account, or to two for a transfer between them.
+func (e JournalEntry) Book(accounts account_entity.Accounts) (Booking, error) {
+	byName := map[string]account_entity.Account{}
+	for _, account := range accounts {
+		byName[accountKey(account.Name)] = account
+	}
+
+	postings := JournalPostings{}
+	matched := []account_entity.Account{}
+	for _, posting := range e.Postings {
+		components := strings.Split(posting.Account, ":")
+		if account, ok := byName[accountKey(components[len(components)-1])]; ok {
+			postings = append(postings, posting)
+			matched = append(matched, account)
+		}
+	}
+
+	booking := Booking{
+		Line:        e.Line,
+		CreatedAt:   e.Date,
+		Description: e.Description,
+	}
+
+	for i, posting := range postings {
+		if posting.Amount.Currency != matched[i].Currency {
+			return Booking{}, fmt.Errorf("posting to %s is in %s but the account is in %s", posting.Account, posting.Amount.Currency, matched[i].Currency)
+		}
+	}
+
+	switch len(postings) {
+	case 0:
+		return Booking{}, errors.New("no posting to a banda account")
+	case 1:
+		booking.Account = matched[0].Name
+		booking.Amount = postings[0].Amount.Abs()
+		booking.Direction = transaction_types.Income
+		if postings[0].Amount.IsNegative() {
+			booking.Direction = transaction_types.Expense
+		}
+	case 2:
+		if len(e.Postings) != 2 || postings[0].Amount.Amount != -postings[1].Amount.Amount {
+			return Booking{}, errors.New("transfer between banda accounts must have no other postings")
+		}
+
+		source, destination := 0, 1
+		if postings[1].Amount.IsNegative() {
+			source, destination = 1, 0
+		}
+
+		booking.Account = matched[source].Name
+		booking.Destination = matched[destination].Name
+		booking.Amount = postings[destination].Amount
+		booking.Direction = transaction_types.Transfer
+	default:
+		return Booking{}, errors.New("posting to more than two banda accounts")
+	}
+
+	if booking.Amount.IsZero() {
+		return Booking{}, errors.New("zero amount")
+	}
+
+	return booking, nil
+}
+
+// accountKey keeps only the lowercased letters and digits of an account name.
+func accountKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+
+		return -1
+	}, name)
+}
@@ -0,0 +1,291 @@
+package importer_entity
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+func TestParseCommodityAmount(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    common_types.Money
+		wantErr bool
+	}{
+		{value: "12.50 USD", want: common_types.NewMoney(1250, "USD")},
+		{value: "-12.50 USD", want: common_types.NewMoney(-1250, "USD")},
+		{value: "USD -12.50", want: common_types.NewMoney(-1250, "USD")},
+		{value: "$-12.50", want: common_types.NewMoney(-1250, "USD")},
+		{value: "-$12.50", want: common_types.NewMoney(-1250, "USD")},
+		{value: "€1,234.5", want: common_types.NewMoney(123450, "EUR")},
+		{value: "Rp 15,000", want: common_types.NewMoney(1500000, "IDR")},
+		{value: "1000 JPY", want: common_types.NewMoney(1000, "JPY")},
+		{value: `10 "eur"`, want: common_types.NewMoney(1000, "EUR")},
+		{value: "12.50", wantErr: true},
+		{value: "USD 12.50 EUR", wantErr: true},
+		{value: "USD", wantErr: true},
+		{value: "1.5 JPY", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseCommodityAmount(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCommodityAmount(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("parseCommodityAmount(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJournalPosting(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    JournalPosting
+		wantErr bool
+	}{
+		{name: "ledger account with spaces", line: "Assets:Bank Main  -12.50 USD", want: JournalPosting{Account: "Assets:Bank Main", Amount: common_types.NewMoney(-1250, "USD")}},
+		{name: "tab separator", line: "Expenses:Food\t$12.50", want: JournalPosting{Account: "Expenses:Food", Amount: common_types.NewMoney(1250, "USD")}},
+		{name: "beancount single space", line: "Expenses:Food 12.50 USD", want: JournalPosting{Account: "Expenses:Food", Amount: common_types.NewMoney(1250, "USD")}},
+		{name: "elided amount", line: "Assets:Bank Main", want: JournalPosting{Account: "Assets:Bank Main"}},
+		{name: "posting status and comment", line: "* Expenses:Food  5 USD ; lunch", want: JournalPosting{Account: "Expenses:Food", Amount: common_types.NewMoney(500, "USD")}},
+		{name: "price is ignored", line: "Assets:Wallet  10 EUR @ 1.10 USD", want: JournalPosting{Account: "Assets:Wallet", Amount: common_types.NewMoney(1000, "EUR")}},
+		{name: "cost is ignored", line: "Assets:Wallet 10 EUR {1.10 USD}", want: JournalPosting{Account: "Assets:Wallet", Amount: common_types.NewMoney(1000, "EUR")}},
+		{name: "balance assertion is ignored", line: "Assets:Bank  -5 USD = 95 USD", want: JournalPosting{Account: "Assets:Bank", Amount: common_types.NewMoney(-500, "USD")}},
+		{name: "virtual posting", line: "(Budget:Food)  -5 USD", wantErr: true},
+		{name: "balanced virtual posting", line: "[Budget:Food]  -5 USD", wantErr: true},
+		{name: "missing commodity", line: "Expenses:Food  5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseJournalPosting(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseJournalPosting(%q) error = %v, wantErr %v", tt.line, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("parseJournalPosting(%q) = %+v, want %+v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJournal(t *testing.T) {
+	tests := []struct {
+		name            string
+		journal         string
+		wantEntries     JournalEntries
+		wantFails       RowErrors
+		wantUnsupported RowErrors
+	}{
+		{
+			name: "ledger and hledger transactions",
+			journal: `; opening comment
+2024/01/05=2024/01/06 * (1001) Coffee shop ; morning
+    Expenses:Food  4.50 USD
+    Assets:Bank Main
+
+2024-01-06 ! Employer | Salary
+    Assets:Bank Main  1,000.00 USD
+    Income:Salary  -1,000.00 USD
+`,
+			wantEntries: JournalEntries{
+				{Line: 2, Date: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), Description: "Coffee shop", Postings: JournalPostings{
+					{Line: 3, Account: "Expenses:Food", Amount: common_types.NewMoney(450, "USD")},
+					{Line: 4, Account: "Assets:Bank Main", Amount: common_types.NewMoney(-450, "USD")},
+				}},
+				{Line: 6, Date: time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC), Description: "Employer Salary", Postings: JournalPostings{
+					{Line: 7, Account: "Assets:Bank Main", Amount: common_types.NewMoney(100000, "USD")},
+					{Line: 8, Account: "Income:Salary", Amount: common_types.NewMoney(-100000, "USD")},
+				}},
+			},
+			wantFails:       RowErrors{},
+			wantUnsupported: RowErrors{},
+		},
+		{
+			name: "beancount transactions and directives",
+			journal: `option "title" "Books"
+2024-01-01 open Assets:Bank USD
+
+2024-01-07 txn "Bakery" "Bread \"sourdough\"" #food
+  receipt: "r-1"
+  Expenses:Food 3.00 USD
+  Assets:Bank -3.00 USD
+`,
+			wantEntries: JournalEntries{
+				{Line: 4, Date: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC), Description: `Bakery Bread "sourdough"`, Postings: JournalPostings{
+					{Line: 6, Account: "Expenses:Food", Amount: common_types.NewMoney(300, "USD")},
+					{Line: 7, Account: "Assets:Bank", Amount: common_types.NewMoney(-300, "USD")},
+				}},
+			},
+			wantFails: RowErrors{},
+			wantUnsupported: RowErrors{
+				{Line: 1, Reason: `unsupported directive "option"`},
+				{Line: 2, Reason: `unsupported directive "open"`},
+			},
+		},
+		{
+			name: "reports the entries that do not parse",
+			journal: `2024-13-01 Bad date
+    Expenses:Food  1 USD
+
+2024-01-02 Unbalanced
+    Expenses:Food  1 USD
+    Assets:Bank  -2 USD
+
+2024-01-03 Two elided
+    Expenses:Food
+    Assets:Bank
+
+2024-01-04 Bad posting
+    Expenses:Food  1
+    Assets:Bank
+
+    Assets:Bank  1 USD
+
+comment
+2024-01-05 Commented out
+end comment
+`,
+			wantEntries: JournalEntries{},
+			wantFails: RowErrors{
+				{Line: 1, Reason: `invalid date "2024-13-01"`},
+				{Line: 4, Reason: "transaction does not balance"},
+				{Line: 8, Reason: "more than one posting without an amount"},
+				{Line: 13, Reason: `missing commodity in amount "1"`},
+				{Line: 16, Reason: "posting outside of a transaction"},
+			},
+			wantUnsupported: RowErrors{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, fails, unsupported, err := ParseJournal(strings.NewReader(tt.journal))
+			if err != nil {
+				t.Fatalf("ParseJournal() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(entries, tt.wantEntries) {
+				t.Errorf("ParseJournal() entries = %+v, want %+v", entries, tt.wantEntries)
+			}
+
+			if !reflect.DeepEqual(fails, tt.wantFails) {
+				t.Errorf("ParseJournal() errors = %+v, want %+v", fails, tt.wantFails)
+			}
+
+			if !reflect.DeepEqual(unsupported, tt.wantUnsupported) {
+				t.Errorf("ParseJournal() unsupported = %+v, want %+v", unsupported, tt.wantUnsupported)
+			}
+		})
+	}
+}
+
+func TestJournalEntryBook(t *testing.T) {
+	accounts := account_entity.Accounts{
+		{Name: "BCA Main", Currency: "IDR"},
+		{Name: "Wallet", Currency: "IDR"},
+		{Name: "Travel", Currency: "USD"},
+	}
+
+	date := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+	entry := func(postings ...JournalPosting) JournalEntry {
+		return JournalEntry{Line: 1, Date: date, Description: "Entry", Postings: postings}
+	}
+
+	tests := []struct {
+		name    string
+		entry   JournalEntry
+		want    Booking
+		wantErr string
+	}{
+		{
+			name: "expense",
+			entry: entry(
+				JournalPosting{Account: "Expenses:Food", Amount: common_types.NewMoney(5000, "IDR")},
+				JournalPosting{Account: "Assets:BCA-Main", Amount: common_types.NewMoney(-5000, "IDR")},
+			),
+			want: Booking{Line: 1, CreatedAt: date, Description: "Entry", Account: "BCA Main", Amount: common_types.NewMoney(5000, "IDR"), Direction: transaction_types.Expense},
+		},
+		{
+			name: "income",
+			entry: entry(
+				JournalPosting{Account: "Assets:wallet", Amount: common_types.NewMoney(5000, "IDR")},
+				JournalPosting{Account: "Income:Gift", Amount: common_types.NewMoney(-5000, "IDR")},
+			),
+			want: Booking{Line: 1, CreatedAt: date, Description: "Entry", Account: "Wallet", Amount: common_types.NewMoney(5000, "IDR"), Direction: transaction_types.Income},
+		},
+		{
+			name: "transfer",
+			entry: entry(
+				JournalPosting{Account: "Assets:Wallet", Amount: common_types.NewMoney(5000, "IDR")},
+				JournalPosting{Account: "Assets:BCA Main", Amount: common_types.NewMoney(-5000, "IDR")},
+			),
+			want: Booking{Line: 1, CreatedAt: date, Description: "Entry", Account: "BCA Main", Destination: "Wallet", Amount: common_types.NewMoney(5000, "IDR"), Direction: transaction_types.Transfer},
+		},
+		{
+			name: "no banda account",
+			entry: entry(
+				JournalPosting{Account: "Expenses:Food", Amount: common_types.NewMoney(5000, "IDR")},
+				JournalPosting{Account: "Assets:Other", Amount: common_types.NewMoney(-5000, "IDR")},
+			),
+			wantErr: "no posting to a banda account",
+		},
+		{
+			name: "currency of the account",
+			entry: entry(
+				JournalPosting{Account: "Expenses:Food", Amount: common_types.NewMoney(500, "USD")},
+				JournalPosting{Account: "Assets:Wallet", Amount: common_types.NewMoney(-500, "USD")},
+			),
+			wantErr: "posting to Assets:Wallet is in USD but the account is in IDR",
+		},
+		{
+			name: "transfer with other postings",
+			entry: entry(
+				JournalPosting{Account: "Assets:Wallet", Amount: common_types.NewMoney(4000, "IDR")},
+				JournalPosting{Account: "Expenses:Fee", Amount: common_types.NewMoney(1000, "IDR")},
+				JournalPosting{Account: "Assets:BCA Main", Amount: common_types.NewMoney(-5000, "IDR")},
+			),
+			wantErr: "transfer between banda accounts must have no other postings",
+		},
+		{
+			name: "zero amount",
+			entry: entry(
+				JournalPosting{Account: "Expenses:Food", Amount: common_types.NewMoney(0, "IDR")},
+				JournalPosting{Account: "Assets:Wallet", Amount: common_types.NewMoney(0, "IDR")},
+			),
+			wantErr: "zero amount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.entry.Book(accounts)
+			if err != nil {
+				if err.Error() != tt.wantErr {
+					t.Fatalf("Book() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if tt.wantErr != "" {
+				t.Fatalf("Book() error = nil, want %q", tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Book() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
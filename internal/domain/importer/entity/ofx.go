@@ -0,0 +1,250 @@
+package importer_entity
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+// ofxTag is an element of an OFX document with the text that follows it. OFX
+// 1.x is SGML and leaves the elements holding a value unclosed, while OFX 2.x
+// is XML and closes every element, so the value is read up to the next tag in
+// both versions.
+type ofxTag struct {
+	Line    int
+	Name    string
+	Closing bool
+	Value   string
+}
+
+// ParseOFX parses the STMTTRN entries of an OFX 1.x or 2.x download and
+// returns them with the currency of the statement. Entries that do not parse
+// are reported with the line they start on and left out. Amounts are converted
+// to minor units with the exponent of the account currency.
+func ParseOFX(r io.Reader, exponent int) (string, Rows, RowErrors, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	tags, err := scanOFX(string(data))
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	currency := ""
+	rows := Rows{}
+	rowErrors := RowErrors{}
+
+	var entry map[string]string
+	line := 0
+	flush := func() {
+		if entry == nil {
+			return
+		}
+
+		row, err := parseOFXEntry(entry, exponent)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{
+				Line:   line,
+				Reason: err.Error(),
+			})
+		} else {
+			row.Line = line
+			rows = append(rows, row)
+		}
+
+		entry = nil
+	}
+
+	for _, tag := range tags {
+		switch {
+		case tag.Name == "STMTTRN" && !tag.Closing:
+			flush()
+			entry, line = map[string]string{}, tag.Line
+		case tag.Name == "STMTTRN" || tag.Name == "BANKTRANLIST":
+			flush()
+		case tag.Closing || tag.Value == "":
+		case entry != nil:
+			if _, ok := entry[tag.Name]; !ok {
+				entry[tag.Name] = tag.Value
+			}
+		case tag.Name == "CURDEF" && currency == "":
+			currency = strings.ToUpper(tag.Value)
+		}
+	}
+
+	flush()
+
+	return currency, rows, rowErrors, nil
+}
+
+// scanOFX splits the body of the document into tags. The header before the
+// OFX element, SGML key value pairs or XML processing instructions, is skipped.
+func scanOFX(document string) ([]ofxTag, error) {
+	start := strings.Index(document, "<OFX>")
+	if start < 0 {
+		return nil, errors.New("missing OFX element")
+	}
+
+	tags := []ofxTag{}
+	line := 1 + strings.Count(document[:start], "\n")
+	for i := start; i < len(document); {
+		open := strings.IndexByte(document[i:], '<')
+		if open < 0 {
+			break
+		}
+
+		line += strings.Count(document[i:i+open], "\n")
+		i += open
+
+		closing := strings.IndexByte(document[i:], '>')
+		if closing < 0 {
+			return nil, fmt.Errorf("unterminated tag on line %d", line)
+		}
+
+		name := strings.TrimSpace(document[i+1 : i+closing])
+		i += closing + 1
+
+		end := strings.IndexByte(document[i:], '<')
+		if end < 0 {
+			end = len(document) - i
+		}
+
+		if name == "" || name[0] == '?' || name[0] == '!' {
+			continue
+		}
+
+		tag := ofxTag{
+			Line:  line,
+			Name:  strings.ToUpper(strings.TrimPrefix(name, "/")),
+			Value: html.UnescapeString(strings.TrimSpace(document[i : i+end])),
+		}
+		tag.Closing = strings.HasPrefix(name, "/")
+
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+func parseOFXEntry(entry map[string]string, exponent int) (Row, error) {
+	row := Row{
+		ExternalID:  entry["FITID"],
+		Description: ofxDescription(entry),
+	}
+
+	if row.ExternalID == "" {
+		return Row{}, errors.New("missing FITID")
+	}
+
+	if row.Description == "" {
+		return Row{}, errors.New("empty description")
+	}
+
+	value, ok := entry["DTPOSTED"]
+	if !ok {
+		return Row{}, errors.New("missing DTPOSTED")
+	}
+
+	createdAt, err := parseOFXDate(value)
+	if err != nil {
+		return Row{}, err
+	}
+
+	row.CreatedAt = createdAt
+
+	value, ok = entry["TRNAMT"]
+	if !ok {
+		return Row{}, errors.New("missing TRNAMT")
+	}
+
+	// Some issuers write the amount with a decimal comma.
+	decimalSeparator := "."
+	if !strings.Contains(value, ".") {
+		decimalSeparator = ","
+	}
+
+	amount, err := parseAmount(value, decimalSeparator, "", exponent)
+	if err != nil {
+		return Row{}, err
+	}
+
+	row.Amount, row.Direction = amount, transaction_types.Income
+	if amount < 0 {
+		row.Amount, row.Direction = -amount, transaction_types.Expense
+	}
+
+	if row.Amount == 0 {
+		return Row{}, errors.New("zero amount")
+	}
+
+	return row, nil
+}
+
+// ofxDescription joins the payee name and the memo, falling back to the
+// transaction type when the entry has neither.
+func ofxDescription(entry map[string]string) string {
+	name, memo := entry["NAME"], entry["MEMO"]
+
+	switch {
+	case name != "" && memo != "" && !strings.Contains(name, memo):
+		return strings.Join(strings.Fields(name+" "+memo), " ")
+	case name != "":
+		return strings.Join(strings.Fields(name), " ")
+	case memo != "":
+		return strings.Join(strings.Fields(memo), " ")
+	default:
+		return entry["TRNTYPE"]
+	}
+}
+
+// parseOFXDate reads a YYYYMMDD[HHMM[SS[.XXX]]][offset:zone] datetime. Dates
+// without an offset are in GMT.
+func parseOFXDate(value string) (time.Time, error) {
+	original := value
+	location := time.UTC
+
+	if i := strings.Index(value, "["); i >= 0 {
+		zone := strings.TrimSuffix(value[i+1:], "]")
+		value = value[:i]
+
+		offset, name, _ := strings.Cut(zone, ":")
+		hours, err := strconv.ParseFloat(offset, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q", original)
+		}
+
+		if name == "" {
+			name = offset
+		}
+
+		location = time.FixedZone(name, int(hours*3600))
+	}
+
+	value, _, _ = strings.Cut(value, ".")
+
+	layouts := map[int]string{
+		8:  "20060102",
+		12: "200601021504",
+		14: "20060102150405",
+	}
+
+	layout, ok := layouts[len(value)]
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid date %q", original)
+	}
+
+	createdAt, err := time.ParseInLocation(layout, value, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", original)
+	}
+
+	return createdAt, nil
+}
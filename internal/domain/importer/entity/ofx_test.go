@@ -0,0 +1,182 @@
+package importer_entity
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+func TestParseOFXDate(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		want       time.Time
+		wantOffset int
+		wantErr    bool
+	}{
+		{name: "date only", value: "20240131", want: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "date and minutes", value: "202401311530", want: time.Date(2024, 1, 31, 15, 30, 0, 0, time.UTC)},
+		{name: "date and seconds", value: "20240131153045", want: time.Date(2024, 1, 31, 15, 30, 45, 0, time.UTC)},
+		{name: "milliseconds are dropped", value: "20240131153045.123", want: time.Date(2024, 1, 31, 15, 30, 45, 0, time.UTC)},
+		{name: "offset with zone name", value: "20240131153045.000[+7:WIB]", want: time.Date(2024, 1, 31, 8, 30, 45, 0, time.UTC), wantOffset: 7 * 3600},
+		{name: "negative offset", value: "20240131120000[-5:EST]", want: time.Date(2024, 1, 31, 17, 0, 0, 0, time.UTC), wantOffset: -5 * 3600},
+		{name: "fractional offset", value: "20240131120000[5.5]", want: time.Date(2024, 1, 31, 6, 30, 0, 0, time.UTC), wantOffset: 5*3600 + 1800},
+		{name: "bad offset", value: "20240131[GMT]", wantErr: true},
+		{name: "wrong length", value: "2024013", wantErr: true},
+		{name: "not a date", value: "20241331", wantErr: true},
+		{name: "empty", value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOFXDate(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseOFXDate(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("parseOFXDate(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+
+			if _, offset := got.Zone(); offset != tt.wantOffset {
+				t.Errorf("parseOFXDate(%q) offset = %d, want %d", tt.value, offset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+const ofxSGML = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<STMTRS>
+<CURDEF>idr
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240105
+<TRNAMT>-45000.00
+<FITID>A1
+<NAME>Coffee &amp; Co
+<MEMO>Card 1234
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240106120000
+<TRNAMT>1500000,50
+<FITID>A2
+<MEMO>Salary
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240107
+<TRNAMT>-1000
+<NAME>No id
+</BANKTRANLIST>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`
+
+const ofxXML = `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="211"?>
+<OFX>
+  <BANKMSGSRSV1><STMTTRNRS><STMTRS>
+    <CURDEF>USD</CURDEF>
+    <BANKTRANLIST>
+      <STMTTRN>
+        <TRNTYPE>FEE</TRNTYPE>
+        <DTPOSTED>20240201</DTPOSTED>
+        <TRNAMT>-2.50</TRNAMT>
+        <FITID>B1</FITID>
+      </STMTTRN>
+      <STMTTRN>
+        <TRNTYPE>DEBIT</TRNTYPE>
+        <DTPOSTED>20240202</DTPOSTED>
+        <TRNAMT>0.00</TRNAMT>
+        <FITID>B2</FITID>
+        <NAME>Nothing</NAME>
+      </STMTTRN>
+    </BANKTRANLIST>
+  </STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+func TestParseOFX(t *testing.T) {
+	tests := []struct {
+		name         string
+		document     string
+		wantCurrency string
+		wantRows     Rows
+		wantFails    RowErrors
+		wantErr      bool
+	}{
+		{
+			name:         "OFX 1.x leaves elements unclosed",
+			document:     ofxSGML,
+			wantCurrency: "IDR",
+			wantRows: Rows{
+				{Line: 11, ExternalID: "A1", CreatedAt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), Description: "Coffee & Co Card 1234", Amount: 4500000, Direction: transaction_types.Expense},
+				{Line: 18, ExternalID: "A2", CreatedAt: time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC), Description: "Salary", Amount: 150000050, Direction: transaction_types.Income},
+			},
+			wantFails: RowErrors{
+				{Line: 24, Reason: "missing FITID"},
+			},
+		},
+		{
+			name:         "OFX 2.x closes every element",
+			document:     ofxXML,
+			wantCurrency: "USD",
+			wantRows: Rows{
+				{Line: 7, ExternalID: "B1", CreatedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Description: "FEE", Amount: 250, Direction: transaction_types.Expense},
+			},
+			wantFails: RowErrors{
+				{Line: 13, Reason: "zero amount"},
+			},
+		},
+		{
+			name:     "not an OFX document",
+			document: "Date,Description,Amount\n",
+			wantErr:  true,
+		},
+		{
+			name:     "unterminated tag",
+			document: "<OFX><STMTTRN",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currency, rows, fails, err := ParseOFX(strings.NewReader(tt.document), 2)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseOFX() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if currency != tt.wantCurrency {
+				t.Errorf("ParseOFX() currency = %q, want %q", currency, tt.wantCurrency)
+			}
+
+			if !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Errorf("ParseOFX() rows = %+v, want %+v", rows, tt.wantRows)
+			}
+
+			if !reflect.DeepEqual(fails, tt.wantFails) {
+				t.Errorf("ParseOFX() errors = %+v, want %+v", fails, tt.wantFails)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package importer_entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NoColumn leaves a column of the profile unmapped.
+const NoColumn = -1
+
+// Profile maps the columns of a bank CSV export onto transactions, since every
+// bank lays its statement out differently. Columns are zero-based. Amounts
+// are either signed in one column, negative for expenses, or split over a
+// debit and a credit column.
+type Profile struct {
+	ID        uuid.UUID
+	Name      string
+	Delimiter string
+	// SkipRows is the number of leading records, e.g. the account summary and
+	// the column titles, that are not transactions.
+	SkipRows          int
+	DateColumn        int
+	DateFormat        string
+	DescriptionColumn int
+	AmountColumn      int
+	DebitColumn       int
+	CreditColumn      int
+	DecimalSeparator  string
+	ThousandSeparator string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type Profiles []Profile
+
+var NoProfile = Profile{}
+var NoProfiles = []Profile{}
+
+var dateTokens = strings.NewReplacer(
+	"YYYY", "2006",
+	"YY", "06",
+	"MMM", "Jan",
+	"MM", "01",
+	"DD", "02",
+	"HH", "15",
+	"mm", "04",
+	"ss", "05",
+)
+
+// Layout translates the date format, e.g. DD/MM/YYYY, into a Go time layout.
+func (p Profile) Layout() string {
+	return dateTokens.Replace(p.DateFormat)
+}
+
+func (p Profile) HasAmountColumn() bool {
+	return p.AmountColumn != NoColumn
+}
+
+func (p Profile) HasDebitCreditColumns() bool {
+	return p.DebitColumn != NoColumn && p.CreditColumn != NoColumn
+}
+
+// Comma returns the field delimiter, a comma unless configured otherwise.
+func (p Profile) Comma() rune {
+	for _, r := range p.Delimiter {
+		return r
+	}
+
+	return ','
+}
+
+// Columns lists every mapped column.
+func (p Profile) Columns() []int {
+	columns := []int{}
+	for _, column := range []int{p.DateColumn, p.DescriptionColumn, p.AmountColumn, p.DebitColumn, p.CreditColumn} {
+		if column != NoColumn {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns
+}
@@ -0,0 +1,209 @@
+package importer_entity
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+// Row is a statement line parsed into the fields of a transaction.
+type Row struct {
+	Line int
+	// ExternalID is the id the bank gave the entry, empty when the format
+	// has none.
+	ExternalID string
+	CreatedAt  time.Time
+	// ValueDate is only known for formats that tell it apart from the
+	// booking date.
+	ValueDate   time.Time
+	Description string
+	Amount      int64
+	Direction   transaction_types.Direction
+}
+
+type Rows []Row
+
+// RowError explains why a statement line could not be parsed.
+type RowError struct {
+	Line   int
+	Reason string
+}
+
+type RowErrors []RowError
+
+// ParseCSV parses every record after the skipped ones. Blank records are
+// ignored, records that do not parse are reported and left out. Amounts are
+// converted to minor units with the exponent of the account currency.
+func (p Profile) ParseCSV(r io.Reader, exponent int) (Rows, RowErrors, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = p.Comma()
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	rows := Rows{}
+	rowErrors := RowErrors{}
+	for n := 1; ; n++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if n <= p.SkipRows || isBlank(record) {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		row, err := p.parseRecord(record, exponent)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{
+				Line:   line,
+				Reason: err.Error(),
+			})
+			continue
+		}
+
+		row.Line = line
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+func (p Profile) parseRecord(record []string, exponent int) (Row, error) {
+	for _, column := range p.Columns() {
+		if column >= len(record) {
+			return Row{}, fmt.Errorf("missing column %d", column)
+		}
+	}
+
+	value := strings.TrimSpace(record[p.DateColumn])
+	createdAt, err := time.Parse(p.Layout(), value)
+	if err != nil {
+		return Row{}, fmt.Errorf("invalid date %q", value)
+	}
+
+	row := Row{
+		CreatedAt:   createdAt,
+		Description: strings.Join(strings.Fields(record[p.DescriptionColumn]), " "),
+	}
+
+	if row.Description == "" {
+		return Row{}, errors.New("empty description")
+	}
+
+	if p.HasAmountColumn() {
+		amount, err := p.parseAmount(record[p.AmountColumn], exponent)
+		if err != nil {
+			return Row{}, err
+		}
+
+		row.Amount, row.Direction = amount, transaction_types.Income
+		if amount < 0 {
+			row.Amount, row.Direction = -amount, transaction_types.Expense
+		}
+	} else {
+		debit, err := p.parseAmount(record[p.DebitColumn], exponent)
+		if err != nil {
+			return Row{}, err
+		}
+
+		credit, err := p.parseAmount(record[p.CreditColumn], exponent)
+		if err != nil {
+			return Row{}, err
+		}
+
+		if debit != 0 && credit != 0 {
+			return Row{}, errors.New("both debit and credit are filled")
+		}
+
+		row.Amount, row.Direction = credit, transaction_types.Income
+		if debit != 0 {
+			row.Amount, row.Direction = debit, transaction_types.Expense
+		}
+
+		if row.Amount < 0 {
+			row.Amount = -row.Amount
+		}
+	}
+
+	if row.Amount == 0 {
+		return Row{}, errors.New("zero amount")
+	}
+
+	return row, nil
+}
+
+func (p Profile) parseAmount(value string, exponent int) (int64, error) {
+	return parseAmount(value, p.DecimalSeparator, p.ThousandSeparator, exponent)
+}
+
+// parseAmount reads a decimal amount written with the given separators into
+// minor units. Empty values are zero, negative values carry a leading minus or
+// are wrapped in parentheses.
+func parseAmount(value string, decimalSeparator string, thousandSeparator string, exponent int) (int64, error) {
+	original := value
+	value = strings.ReplaceAll(strings.TrimSpace(value), " ", "")
+	if value == "" {
+		return 0, nil
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative, value = true, value[1:len(value)-1]
+	}
+
+	if strings.HasPrefix(value, "-") {
+		negative, value = true, value[1:]
+	}
+
+	if thousandSeparator != "" {
+		value = strings.ReplaceAll(value, thousandSeparator, "")
+	}
+
+	whole, fraction, _ := strings.Cut(value, decimalSeparator)
+	if len(fraction) > exponent {
+		if strings.Trim(fraction[exponent:], "0") != "" {
+			return 0, fmt.Errorf("amount %q has more than %d decimals", original, exponent)
+		}
+
+		fraction = fraction[:exponent]
+	}
+
+	digits := whole + fraction + strings.Repeat("0", exponent-len(fraction))
+	if whole == "" || strings.Trim(digits, "0123456789") != "" {
+		return 0, fmt.Errorf("invalid amount %q", original)
+	}
+
+	amount, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", original)
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	return amount, nil
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+
+	return true
+}
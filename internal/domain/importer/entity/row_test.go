@@ -0,0 +1,150 @@
+package importer_entity
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name              string
+		value             string
+		decimalSeparator  string
+		thousandSeparator string
+		exponent          int
+		want              int64
+		wantErr           bool
+	}{
+		{name: "empty is zero", value: "  ", decimalSeparator: ".", exponent: 2, want: 0},
+		{name: "whole amount", value: "12", decimalSeparator: ".", exponent: 2, want: 1200},
+		{name: "decimal amount", value: "12.34", decimalSeparator: ".", exponent: 2, want: 1234},
+		{name: "short fraction", value: "12.3", decimalSeparator: ".", exponent: 2, want: 1230},
+		{name: "trailing zero decimals", value: "12.3400", decimalSeparator: ".", exponent: 2, want: 1234},
+		{name: "thousand separator", value: "1,234,567.89", decimalSeparator: ".", thousandSeparator: ",", exponent: 2, want: 123456789},
+		{name: "european separators", value: "1.234.567,89", decimalSeparator: ",", thousandSeparator: ".", exponent: 2, want: 123456789},
+		{name: "spaces in the amount", value: " 1 234,50 ", decimalSeparator: ",", exponent: 2, want: 123450},
+		{name: "leading minus", value: "-12.34", decimalSeparator: ".", exponent: 2, want: -1234},
+		{name: "parentheses", value: "(12.34)", decimalSeparator: ".", exponent: 2, want: -1234},
+		{name: "zero exponent", value: "15000", decimalSeparator: ".", exponent: 0, want: 15000},
+		{name: "three decimal currency", value: "1.234", decimalSeparator: ".", exponent: 3, want: 1234},
+		{name: "too many decimals", value: "12.345", decimalSeparator: ".", exponent: 2, wantErr: true},
+		{name: "decimals on a zero exponent", value: "12.5", decimalSeparator: ".", exponent: 0, wantErr: true},
+		{name: "no whole part", value: ".50", decimalSeparator: ".", exponent: 2, wantErr: true},
+		{name: "letters", value: "12a", decimalSeparator: ".", exponent: 2, wantErr: true},
+		{name: "currency symbol", value: "$12", decimalSeparator: ".", exponent: 2, wantErr: true},
+		{name: "overflow", value: "99999999999999999999", decimalSeparator: ".", exponent: 2, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Profile{DecimalSeparator: tt.decimalSeparator, ThousandSeparator: tt.thousandSeparator}
+			got, err := p.parseAmount(tt.value, tt.exponent)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAmount(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("parseAmount(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfileParseCSV(t *testing.T) {
+	signed := Profile{
+		SkipRows:          1,
+		DateColumn:        0,
+		DateFormat:        "DD/MM/YYYY",
+		DescriptionColumn: 1,
+		AmountColumn:      2,
+		DebitColumn:       NoColumn,
+		CreditColumn:      NoColumn,
+		DecimalSeparator:  ".",
+		ThousandSeparator: ",",
+	}
+
+	split := Profile{
+		Delimiter:         ";",
+		DateColumn:        0,
+		DateFormat:        "YYYY-MM-DD",
+		DescriptionColumn: 1,
+		AmountColumn:      NoColumn,
+		DebitColumn:       2,
+		CreditColumn:      3,
+		DecimalSeparator:  ",",
+		ThousandSeparator: ".",
+	}
+
+	tests := []struct {
+		name      string
+		profile   Profile
+		csv       string
+		wantRows  Rows
+		wantFails RowErrors
+	}{
+		{
+			name:    "signed amount column",
+			profile: signed,
+			csv: "Date,Description,Amount\n" +
+				"01/02/2024,  Coffee   shop ,\"-45,000.00\"\n" +
+				"\n" +
+				"02/02/2024,Salary,\"10,000,000.00\"\n",
+			wantRows: Rows{
+				{Line: 2, CreatedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Description: "Coffee shop", Amount: 4500000, Direction: transaction_types.Expense},
+				{Line: 4, CreatedAt: time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), Description: "Salary", Amount: 1000000000, Direction: transaction_types.Income},
+			},
+			wantFails: RowErrors{},
+		},
+		{
+			name:    "debit and credit columns",
+			profile: split,
+			csv: "2024-03-01;Groceries;125,50;\n" +
+				"2024-03-02;Refund;;20,00\n",
+			wantRows: Rows{
+				{Line: 1, CreatedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Description: "Groceries", Amount: 12550, Direction: transaction_types.Expense},
+				{Line: 2, CreatedAt: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), Description: "Refund", Amount: 2000, Direction: transaction_types.Income},
+			},
+			wantFails: RowErrors{},
+		},
+		{
+			name:    "reports the records that do not parse",
+			profile: split,
+			csv: "2024-03-01;Groceries\n" +
+				"03/01/2024;Groceries;1,00;\n" +
+				"2024-03-01;   ;1,00;\n" +
+				"2024-03-01;Groceries;1,00;2,00\n" +
+				"2024-03-01;Groceries;0;\n" +
+				"2024-03-01;Groceries;abc;\n",
+			wantRows: Rows{},
+			wantFails: RowErrors{
+				{Line: 1, Reason: "missing column 2"},
+				{Line: 2, Reason: `invalid date "03/01/2024"`},
+				{Line: 3, Reason: "empty description"},
+				{Line: 4, Reason: "both debit and credit are filled"},
+				{Line: 5, Reason: "zero amount"},
+				{Line: 6, Reason: `invalid amount "abc"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, fails, err := tt.profile.ParseCSV(strings.NewReader(tt.csv), 2)
+			if err != nil {
+				t.Fatalf("ParseCSV() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Errorf("ParseCSV() rows = %+v, want %+v", rows, tt.wantRows)
+			}
+
+			if !reflect.DeepEqual(fails, tt.wantFails) {
+				t.Errorf("ParseCSV() errors = %+v, want %+v", fails, tt.wantFails)
+			}
+		})
+	}
+}
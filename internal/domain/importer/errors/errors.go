@@ -0,0 +1,51 @@
+package importer_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrProfileNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "IMPORT_PROFILE_NOT_FOUND_ERROR",
+		Message: "Import profile not found. Please pass valid profile id or name.",
+	}
+
+	ErrProfileAlreadyExist = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "IMPORT_PROFILE_ALREADY_EXIST_ERROR",
+		Message: "Import profile already exists. Please use different name.",
+	}
+
+	ErrProfileNameEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "IMPORT_PROFILE_NAME_EMPTY_ERROR",
+		Message: "Import profile name is empty. Please pass non-empty name.",
+	}
+
+	ErrProfileInvalid = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "IMPORT_PROFILE_INVALID_ERROR",
+		Template: "Import profile is not valid: %s.",
+	}
+
+	ErrImportFileInvalid = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "IMPORT_FILE_INVALID_ERROR",
+		Template: "Import file is not valid: %s.",
+	}
+
+	ErrImportCurrencyMismatch = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "IMPORT_CURRENCY_MISMATCH_ERROR",
+		Template: "Import file is in %s but the account is in %s. Please choose an account in the same currency.",
+	}
+
+	ErrImportRowInvalid = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "IMPORT_ROW_INVALID_ERROR",
+		Template: "Import file cannot be imported, line %d: %s. Please check the preview.",
+	}
+)
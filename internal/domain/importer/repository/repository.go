@@ -0,0 +1,10 @@
+package importer_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/specification"
+)
+
+type ProfileRepository common_repository.Repository[importer_entity.Profile, importer_specification.ProfileSpecification]
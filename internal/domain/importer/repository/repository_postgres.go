@@ -0,0 +1,162 @@
+package importer_repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/specification"
+)
+
+type PostgresProfileRow struct {
+	ID                uuid.UUID
+	Name              string
+	Delimiter         string
+	SkipRows          int
+	DateColumn        int
+	DateFormat        string
+	DescriptionColumn int
+	AmountColumn      sql.NullInt32
+	DebitColumn       sql.NullInt32
+	CreditColumn      sql.NullInt32
+	DecimalSeparator  string
+	ThousandSeparator string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func NewPostgresProfileRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (ProfileRepository, error) {
+	return postgres_repository.New[importer_entity.Profile, importer_specification.ProfileSpecification, *PostgresProfileRow](postgres_repository.Option[importer_entity.Profile, importer_specification.ProfileSpecification, *PostgresProfileRow]{
+		Logger:    logger,
+		TableName: "import_profiles",
+		Schema: map[string]string{
+			"id":                 postgres_repository.UUID,
+			"name":               postgres_repository.CharacterVarying,
+			"delimiter":          postgres_repository.Character,
+			"skip_rows":          postgres_repository.Integer,
+			"date_column":        postgres_repository.Integer,
+			"date_format":        postgres_repository.CharacterVarying,
+			"description_column": postgres_repository.Integer,
+			"amount_column":      postgres_repository.Integer,
+			"debit_column":       postgres_repository.Integer,
+			"credit_column":      postgres_repository.Integer,
+			"decimal_separator":  postgres_repository.Character,
+			"thousand_separator": postgres_repository.CharacterVarying,
+			"created_at":         postgres_repository.TimestampWithZone,
+			"updated_at":         postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"name",
+			"delimiter",
+			"skip_rows",
+			"date_column",
+			"date_format",
+			"description_column",
+			"amount_column",
+			"debit_column",
+			"credit_column",
+			"decimal_separator",
+			"thousand_separator",
+			"created_at",
+			"updated_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...importer_specification.ProfileSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case importer_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				case importer_specification.NameIsSpecification:
+					where = append(where, squirrel.Expr("LOWER(name) = LOWER(?)", v.Name))
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresProfileRow, error) {
+			row := &PostgresProfileRow{}
+			if err := rows.Scan(&row.ID, &row.Name, &row.Delimiter, &row.SkipRows, &row.DateColumn, &row.DateFormat, &row.DescriptionColumn, &row.AmountColumn, &row.DebitColumn, &row.CreditColumn, &row.DecimalSeparator, &row.ThousandSeparator, &row.CreatedAt, &row.UpdatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresProfileRow) importer_entity.Profile {
+			return importer_entity.Profile{
+				ID:                row.ID,
+				Name:              row.Name,
+				Delimiter:         row.Delimiter,
+				SkipRows:          row.SkipRows,
+				DateColumn:        row.DateColumn,
+				DateFormat:        row.DateFormat,
+				DescriptionColumn: row.DescriptionColumn,
+				AmountColumn:      column(row.AmountColumn),
+				DebitColumn:       column(row.DebitColumn),
+				CreditColumn:      column(row.CreditColumn),
+				DecimalSeparator:  row.DecimalSeparator,
+				ThousandSeparator: row.ThousandSeparator,
+				CreatedAt:         row.CreatedAt,
+				UpdatedAt:         row.UpdatedAt,
+			}
+		},
+		Row: func(profile importer_entity.Profile) *PostgresProfileRow {
+			return &PostgresProfileRow{
+				ID:                profile.ID,
+				Name:              profile.Name,
+				Delimiter:         profile.Delimiter,
+				SkipRows:          profile.SkipRows,
+				DateColumn:        profile.DateColumn,
+				DateFormat:        profile.DateFormat,
+				DescriptionColumn: profile.DescriptionColumn,
+				AmountColumn:      nullColumn(profile.AmountColumn),
+				DebitColumn:       nullColumn(profile.DebitColumn),
+				CreditColumn:      nullColumn(profile.CreditColumn),
+				DecimalSeparator:  profile.DecimalSeparator,
+				ThousandSeparator: profile.ThousandSeparator,
+				CreatedAt:         profile.CreatedAt,
+				UpdatedAt:         profile.UpdatedAt,
+			}
+		},
+		Values: func(row *PostgresProfileRow) []any {
+			return []any{
+				row.ID,
+				row.Name,
+				row.Delimiter,
+				row.SkipRows,
+				row.DateColumn,
+				row.DateFormat,
+				row.DescriptionColumn,
+				row.AmountColumn,
+				row.DebitColumn,
+				row.CreditColumn,
+				row.DecimalSeparator,
+				row.ThousandSeparator,
+				row.CreatedAt,
+				row.UpdatedAt,
+			}
+		},
+	})
+}
+
+func column(value sql.NullInt32) int {
+	if !value.Valid {
+		return importer_entity.NoColumn
+	}
+
+	return int(value.Int32)
+}
+
+func nullColumn(column int) sql.NullInt32 {
+	return sql.NullInt32{
+		Int32: int32(column),
+		Valid: column != importer_entity.NoColumn,
+	}
+}
@@ -0,0 +1,216 @@
+package importer_service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/errors"
+	importer_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/specification"
+	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+func (s *ImporterServiceImpl) getProfile(ctx context.Context, spec importer_specification.ProfileSpecification) (importer_entity.Profile, error) {
+	profile, err := s.profileRepository.Get(ctx, spec)
+	if err != nil {
+		return importer_entity.NoProfile, err
+	}
+
+	if profile == importer_entity.NoProfile {
+		return importer_entity.NoProfile, importer_errors.ErrProfileNotFound
+	}
+
+	return profile, nil
+}
+
+func (s *ImporterServiceImpl) getAccount(ctx context.Context, name string) (account_entity.Account, error) {
+	account, err := s.accountRepository.Get(ctx, account_specification.NameIs(name))
+	if err != nil {
+		return account_entity.NoAccount, err
+	}
+
+	if account == account_entity.NoAccount {
+		return account_entity.NoAccount, account_errors.ErrAccountNotFound
+	}
+
+	return account, nil
+}
+
+func checkProfile(profile importer_entity.Profile) error {
+	if profile.Name == "" {
+		return importer_errors.ErrProfileNameEmpty
+	}
+
+	if utf8.RuneCountInString(profile.Delimiter) != 1 {
+		return importer_errors.ErrProfileInvalid.Format("delimiter must be one character")
+	}
+
+	if profile.SkipRows < 0 {
+		return importer_errors.ErrProfileInvalid.Format("skip rows must not be negative")
+	}
+
+	if profile.DateFormat == "" || profile.Layout() == profile.DateFormat {
+		return importer_errors.ErrProfileInvalid.Format("date format must use YYYY, MM, DD like DD/MM/YYYY")
+	}
+
+	if profile.DateColumn == importer_entity.NoColumn || profile.DescriptionColumn == importer_entity.NoColumn {
+		return importer_errors.ErrProfileInvalid.Format("date and description columns are required")
+	}
+
+	if profile.HasAmountColumn() == profile.HasDebitCreditColumns() {
+		return importer_errors.ErrProfileInvalid.Format("map either an amount column or both debit and credit columns")
+	}
+
+	for _, column := range profile.Columns() {
+		if column < 0 {
+			return importer_errors.ErrProfileInvalid.Format("columns must not be negative")
+		}
+	}
+
+	if profile.DecimalSeparator != "." && profile.DecimalSeparator != "," {
+		return importer_errors.ErrProfileInvalid.Format("decimal separator must be . or ,")
+	}
+
+	switch profile.ThousandSeparator {
+	case "", ".", ",", " ", "'":
+	default:
+		return importer_errors.ErrProfileInvalid.Format("thousand separator must be empty, ., ,, space or '")
+	}
+
+	if profile.ThousandSeparator == profile.DecimalSeparator {
+		return importer_errors.ErrProfileInvalid.Format("decimal and thousand separators must differ")
+	}
+
+	return nil
+}
+
+func (s *ImporterServiceImpl) checkName(ctx context.Context, profile importer_entity.Profile) error {
+	exist, err := s.profileRepository.Exist(ctx, importer_specification.NameIs(profile.Name))
+	if err != nil {
+		return err
+	}
+
+	if exist {
+		return importer_errors.ErrProfileAlreadyExist
+	}
+
+	return nil
+}
+
+// save creates a transaction for every row in one database transaction, so a
+// failing row leaves nothing imported.
+func (s *ImporterServiceImpl) save(ctx context.Context, account account_entity.Account, rows importer_entity.Rows) ([]uuid.UUID, error) {
+	ids := []uuid.UUID{}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		for _, row := range rows {
+			result, err := s.transactionService.CreateTransaction(ctx, &transaction_service.CreateTransactionParams{
+				AccountID:   account.ID,
+				ExternalID:  row.ExternalID,
+				Description: row.Description,
+				Amount:      row.Amount,
+				Currency:    account.Currency,
+				Direction:   row.Direction,
+				CreatedAt:   row.CreatedAt,
+				ValueDate:   row.ValueDate,
+			})
+			if err != nil {
+				return err
+			}
+
+			ids = append(ids, result.Transaction.ID)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// skipImported splits the rows into those to import and those already
+// imported into the account, or repeated in the statement, by their external
+// id. Rows without one are always imported.
+func (s *ImporterServiceImpl) skipImported(ctx context.Context, account account_entity.Account, rows importer_entity.Rows) (importer_entity.Rows, importer_entity.Rows, error) {
+	externalIDs := []string{}
+	for _, row := range rows {
+		if row.ExternalID != "" {
+			externalIDs = append(externalIDs, row.ExternalID)
+		}
+	}
+
+	seen := map[string]bool{}
+	if len(externalIDs) > 0 {
+		transactions, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+			Filters: []transaction_specification.TransactionSpecification{
+				transaction_specification.AccountIs(account.ID),
+				transaction_specification.ExternalIDIn(externalIDs...),
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, transaction := range transactions {
+			seen[transaction.ExternalID] = true
+		}
+	}
+
+	fresh := importer_entity.Rows{}
+	skipped := importer_entity.Rows{}
+	for _, row := range rows {
+		if row.ExternalID != "" && seen[row.ExternalID] {
+			skipped = append(skipped, row)
+			continue
+		}
+
+		if row.ExternalID != "" {
+			seen[row.ExternalID] = true
+		}
+
+		fresh = append(fresh, row)
+	}
+
+	return fresh, skipped, nil
+}
+
+// newProfile fills the defaults of the optional profile fields.
+func newProfile(params *CreateProfileParams) importer_entity.Profile {
+	now := time.Now()
+	profile := importer_entity.Profile{
+		ID:                uuid.New(),
+		Name:              strings.TrimSpace(params.Name),
+		Delimiter:         params.Delimiter,
+		SkipRows:          params.SkipRows,
+		DateColumn:        params.DateColumn,
+		DateFormat:        params.DateFormat,
+		DescriptionColumn: params.DescriptionColumn,
+		AmountColumn:      params.AmountColumn,
+		DebitColumn:       params.DebitColumn,
+		CreditColumn:      params.CreditColumn,
+		DecimalSeparator:  params.DecimalSeparator,
+		ThousandSeparator: params.ThousandSeparator,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	if profile.Delimiter == "" {
+		profile.Delimiter = ","
+	}
+
+	if profile.DecimalSeparator == "" {
+		profile.DecimalSeparator = "."
+	}
+
+	return profile
+}
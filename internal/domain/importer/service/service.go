@@ -0,0 +1,55 @@
+package importer_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
+
+	account_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/repository"
+	importer_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/repository"
+	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
+	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
+)
+
+type ImporterService interface {
+	CreateProfile(ctx context.Context, params *CreateProfileParams) (*CreateProfileResult, error)
+	GetProfile(ctx context.Context, params *GetProfileParams) (*GetProfileResult, error)
+	ListProfiles(ctx context.Context, params *ListProfilesParams) (*ListProfilesResult, error)
+	DeleteProfile(ctx context.Context, params *DeleteProfileParams) (*DeleteProfileResult, error)
+	PreviewCSV(ctx context.Context, params *PreviewCSVParams) (*PreviewCSVResult, error)
+	ImportCSV(ctx context.Context, params *ImportCSVParams) (*ImportCSVResult, error)
+	PreviewOFX(ctx context.Context, params *PreviewOFXParams) (*PreviewOFXResult, error)
+	ImportOFX(ctx context.Context, params *ImportOFXParams) (*ImportOFXResult, error)
+	PreviewCamt(ctx context.Context, params *PreviewCamtParams) (*PreviewCamtResult, error)
+	ImportCamt(ctx context.Context, params *ImportCamtParams) (*ImportCamtResult, error)
+	PreviewJournal(ctx context.Context, params *PreviewJournalParams) (*PreviewJournalResult, error)
+	ImportJournal(ctx context.Context, params *ImportJournalParams) (*ImportJournalResult, error)
+}
+
+type ImporterServiceImpl struct {
+	logger                logger.Logger
+	profileRepository     importer_repository.ProfileRepository
+	accountRepository     account_repository.AccountRepository
+	transactionRepository transaction_repository.TransactionRepository
+	transactionService    transaction_service.TransactionService
+	transactionManager    transaction_manager.TransactionManager
+}
+
+func New(
+	logger logger.Logger,
+	profileRepository importer_repository.ProfileRepository,
+	accountRepository account_repository.AccountRepository,
+	transactionRepository transaction_repository.TransactionRepository,
+	transactionService transaction_service.TransactionService,
+	transactionManager transaction_manager.TransactionManager,
+) ImporterService {
+	return &ImporterServiceImpl{
+		logger:                logger,
+		profileRepository:     profileRepository,
+		accountRepository:     accountRepository,
+		transactionRepository: transactionRepository,
+		transactionService:    transactionService,
+		transactionManager:    transactionManager,
+	}
+}
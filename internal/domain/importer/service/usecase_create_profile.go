@@ -0,0 +1,47 @@
+package importer_service
+
+import (
+	"context"
+
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+)
+
+type CreateProfileParams struct {
+	Name              string
+	Delimiter         string
+	SkipRows          int
+	DateColumn        int
+	DateFormat        string
+	DescriptionColumn int
+	// AmountColumn, DebitColumn and CreditColumn are importer_entity.NoColumn
+	// when unmapped.
+	AmountColumn      int
+	DebitColumn       int
+	CreditColumn      int
+	DecimalSeparator  string
+	ThousandSeparator string
+}
+
+type CreateProfileResult struct {
+	Profile importer_entity.Profile
+}
+
+func (s *ImporterServiceImpl) CreateProfile(ctx context.Context, params *CreateProfileParams) (*CreateProfileResult, error) {
+	profile := newProfile(params)
+
+	if err := checkProfile(profile); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkName(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	if err := s.profileRepository.Save(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return &CreateProfileResult{
+		Profile: profile,
+	}, nil
+}
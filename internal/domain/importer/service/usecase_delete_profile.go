@@ -0,0 +1,28 @@
+package importer_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	importer_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/specification"
+)
+
+type DeleteProfileParams struct {
+	ID uuid.UUID
+}
+
+type DeleteProfileResult struct{}
+
+func (s *ImporterServiceImpl) DeleteProfile(ctx context.Context, params *DeleteProfileParams) (*DeleteProfileResult, error) {
+	profile, err := s.getProfile(ctx, importer_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.profileRepository.Delete(ctx, importer_specification.WithID(profile.ID)); err != nil {
+		return nil, err
+	}
+
+	return &DeleteProfileResult{}, nil
+}
@@ -0,0 +1,29 @@
+package importer_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/specification"
+)
+
+type GetProfileParams struct {
+	ID uuid.UUID
+}
+
+type GetProfileResult struct {
+	Profile importer_entity.Profile
+}
+
+func (s *ImporterServiceImpl) GetProfile(ctx context.Context, params *GetProfileParams) (*GetProfileResult, error) {
+	profile, err := s.getProfile(ctx, importer_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetProfileResult{
+		Profile: profile,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package importer_service
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/errors"
+)
+
+type PreviewCamtParams struct {
+	AccountName string
+	Camt        io.Reader
+}
+
+type PreviewCamtResult struct {
+	Account account_entity.Account
+	Rows    importer_entity.Rows
+	// Skipped are the entries whose reference was imported into the account
+	// before.
+	Skipped importer_entity.Rows
+	Errors  importer_entity.RowErrors
+}
+
+type ImportCamtParams struct {
+	AccountName string
+	Camt        io.Reader
+}
+
+type ImportCamtResult struct {
+	TransactionIDs []uuid.UUID
+	Skipped        int
+	Errors         importer_entity.RowErrors
+}
+
+// PreviewCamt parses the camt.053 statement or camt.052 report without saving
+// anything and tells apart the entries that were already imported.
+func (s *ImporterServiceImpl) PreviewCamt(ctx context.Context, params *PreviewCamtParams) (*PreviewCamtResult, error) {
+	account, err := s.getAccount(ctx, params.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, rows, rowErrors, err := importer_entity.ParseCamt(params.Camt, common_types.CurrencyExponent(account.Currency))
+	if err != nil {
+		return nil, importer_errors.ErrImportFileInvalid.Format(err.Error())
+	}
+
+	if currency != "" && currency != account.Currency {
+		return nil, importer_errors.ErrImportCurrencyMismatch.Format(currency, account.Currency)
+	}
+
+	rows, skipped, err := s.skipImported(ctx, account, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PreviewCamtResult{
+		Account: account,
+		Rows:    rows,
+		Skipped: skipped,
+		Errors:  rowErrors,
+	}, nil
+}
+
+// ImportCamt saves every entry not imported before as a transaction of the
+// account. Unlike the other formats the entries that do not parse, such as
+// pending entries of an intraday report, do not stop the import. They are
+// reported instead, and imported once a later statement books them.
+func (s *ImporterServiceImpl) ImportCamt(ctx context.Context, params *ImportCamtParams) (*ImportCamtResult, error) {
+	preview, err := s.PreviewCamt(ctx, &PreviewCamtParams{
+		AccountName: params.AccountName,
+		Camt:        params.Camt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.save(ctx, preview.Account, preview.Rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportCamtResult{
+		TransactionIDs: ids,
+		Skipped:        len(preview.Skipped),
+		Errors:         preview.Errors,
+	}, nil
+}
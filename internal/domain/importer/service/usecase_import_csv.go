@@ -0,0 +1,89 @@
+package importer_service
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/errors"
+	importer_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/specification"
+)
+
+type PreviewCSVParams struct {
+	ProfileName string
+	AccountName string
+	CSV         io.Reader
+}
+
+type PreviewCSVResult struct {
+	Profile importer_entity.Profile
+	Account account_entity.Account
+	Rows    importer_entity.Rows
+	Errors  importer_entity.RowErrors
+}
+
+type ImportCSVParams struct {
+	ProfileName string
+	AccountName string
+	CSV         io.Reader
+}
+
+type ImportCSVResult struct {
+	TransactionIDs []uuid.UUID
+}
+
+// PreviewCSV parses the statement with the profile without saving anything,
+// so the mapping can be checked before importing.
+func (s *ImporterServiceImpl) PreviewCSV(ctx context.Context, params *PreviewCSVParams) (*PreviewCSVResult, error) {
+	profile, err := s.getProfile(ctx, importer_specification.NameIs(params.ProfileName))
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.getAccount(ctx, params.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, rowErrors, err := profile.ParseCSV(params.CSV, common_types.CurrencyExponent(account.Currency))
+	if err != nil {
+		return nil, importer_errors.ErrImportFileInvalid.Format(err.Error())
+	}
+
+	return &PreviewCSVResult{
+		Profile: profile,
+		Account: account,
+		Rows:    rows,
+		Errors:  rowErrors,
+	}, nil
+}
+
+// ImportCSV saves every row of the statement as a transaction of the account.
+// The statement is refused as a whole when any row does not parse.
+func (s *ImporterServiceImpl) ImportCSV(ctx context.Context, params *ImportCSVParams) (*ImportCSVResult, error) {
+	preview, err := s.PreviewCSV(ctx, &PreviewCSVParams{
+		ProfileName: params.ProfileName,
+		AccountName: params.AccountName,
+		CSV:         params.CSV,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(preview.Errors) > 0 {
+		return nil, importer_errors.ErrImportRowInvalid.Format(preview.Errors[0].Line, preview.Errors[0].Reason)
+	}
+
+	ids, err := s.save(ctx, preview.Account, preview.Rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportCSVResult{
+		TransactionIDs: ids,
+	}, nil
+}
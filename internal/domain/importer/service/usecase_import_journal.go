@@ -0,0 +1,147 @@
+package importer_service
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/errors"
+	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
+)
+
+type PreviewJournalParams struct {
+	Journal io.Reader
+}
+
+type PreviewJournalResult struct {
+	Bookings importer_entity.Bookings
+	Errors   importer_entity.RowErrors
+	// Unsupported are the directives other than transactions, which are left
+	// out of the import.
+	Unsupported importer_entity.RowErrors
+}
+
+type ImportJournalParams struct {
+	Journal io.Reader
+}
+
+type ImportJournalResult struct {
+	TransactionIDs []uuid.UUID
+	Unsupported    importer_entity.RowErrors
+}
+
+// PreviewJournal parses the ledger, hledger or beancount journal and resolves
+// every entry against the accounts without saving anything.
+func (s *ImporterServiceImpl) PreviewJournal(ctx context.Context, params *PreviewJournalParams) (*PreviewJournalResult, error) {
+	entries, rowErrors, unsupported, err := importer_entity.ParseJournal(params.Journal)
+	if err != nil {
+		return nil, importer_errors.ErrImportFileInvalid.Format(err.Error())
+	}
+
+	accounts, err := s.accountRepository.List(ctx, common_repository.ListArgs[account_specification.AccountSpecification]{})
+	if err != nil {
+		return nil, err
+	}
+
+	bookings := importer_entity.Bookings{}
+	for _, entry := range entries {
+		booking, err := entry.Book(accounts)
+		if err != nil {
+			rowErrors = append(rowErrors, importer_entity.RowError{
+				Line:   entry.Line,
+				Reason: err.Error(),
+			})
+			continue
+		}
+
+		bookings = append(bookings, booking)
+	}
+
+	slices.SortStableFunc(rowErrors, func(a, b importer_entity.RowError) int {
+		return a.Line - b.Line
+	})
+
+	return &PreviewJournalResult{
+		Bookings:    bookings,
+		Errors:      rowErrors,
+		Unsupported: unsupported,
+	}, nil
+}
+
+// ImportJournal saves every entry of the journal, a transfer between two
+// accounts as a transfer, in one database transaction. The journal is refused
+// as a whole when any entry does not parse or resolve, while the unsupported
+// directives are only reported.
+func (s *ImporterServiceImpl) ImportJournal(ctx context.Context, params *ImportJournalParams) (*ImportJournalResult, error) {
+	preview, err := s.PreviewJournal(ctx, &PreviewJournalParams{
+		Journal: params.Journal,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(preview.Errors) > 0 {
+		return nil, importer_errors.ErrImportRowInvalid.Format(preview.Errors[0].Line, preview.Errors[0].Reason)
+	}
+
+	accounts, err := s.accountRepository.List(ctx, common_repository.ListArgs[account_specification.AccountSpecification]{})
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]account_entity.Account{}
+	for _, account := range accounts {
+		byName[account.Name] = account
+	}
+
+	ids := []uuid.UUID{}
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		for _, booking := range preview.Bookings {
+			if booking.IsTransfer() {
+				result, err := s.transactionService.CreateTransfer(ctx, &transaction_service.CreateTransferParams{
+					SourceAccountName:      booking.Account,
+					DestinationAccountName: booking.Destination,
+					Description:            booking.Description,
+					Amount:                 booking.Amount.Amount,
+					Currency:               booking.Amount.Currency,
+					CreatedAt:              booking.CreatedAt,
+				})
+				if err != nil {
+					return err
+				}
+
+				ids = append(ids, result.Transfer.Outgoing.ID, result.Transfer.Incoming.ID)
+				continue
+			}
+
+			result, err := s.transactionService.CreateTransaction(ctx, &transaction_service.CreateTransactionParams{
+				AccountID:   byName[booking.Account].ID,
+				Description: booking.Description,
+				Amount:      booking.Amount.Amount,
+				Currency:    booking.Amount.Currency,
+				Direction:   booking.Direction,
+				CreatedAt:   booking.CreatedAt,
+			})
+			if err != nil {
+				return err
+			}
+
+			ids = append(ids, result.Transaction.ID)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ImportJournalResult{
+		TransactionIDs: ids,
+		Unsupported:    preview.Unsupported,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package importer_service
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/errors"
+)
+
+type PreviewOFXParams struct {
+	AccountName string
+	OFX         io.Reader
+}
+
+type PreviewOFXResult struct {
+	Account account_entity.Account
+	Rows    importer_entity.Rows
+	// Skipped are the entries whose FITID was imported into the account
+	// before.
+	Skipped importer_entity.Rows
+	Errors  importer_entity.RowErrors
+}
+
+type ImportOFXParams struct {
+	AccountName string
+	OFX         io.Reader
+}
+
+type ImportOFXResult struct {
+	TransactionIDs []uuid.UUID
+	Skipped        int
+}
+
+// PreviewOFX parses the statement without saving anything and tells apart the
+// entries that were already imported.
+func (s *ImporterServiceImpl) PreviewOFX(ctx context.Context, params *PreviewOFXParams) (*PreviewOFXResult, error) {
+	account, err := s.getAccount(ctx, params.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, rows, rowErrors, err := importer_entity.ParseOFX(params.OFX, common_types.CurrencyExponent(account.Currency))
+	if err != nil {
+		return nil, importer_errors.ErrImportFileInvalid.Format(err.Error())
+	}
+
+	if currency != "" && currency != account.Currency {
+		return nil, importer_errors.ErrImportCurrencyMismatch.Format(currency, account.Currency)
+	}
+
+	rows, skipped, err := s.skipImported(ctx, account, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PreviewOFXResult{
+		Account: account,
+		Rows:    rows,
+		Skipped: skipped,
+		Errors:  rowErrors,
+	}, nil
+}
+
+// ImportOFX saves every entry of the statement not imported before as a
+// transaction of the account, so the same download can be imported twice. The
+// statement is refused as a whole when any entry does not parse.
+func (s *ImporterServiceImpl) ImportOFX(ctx context.Context, params *ImportOFXParams) (*ImportOFXResult, error) {
+	preview, err := s.PreviewOFX(ctx, &PreviewOFXParams{
+		AccountName: params.AccountName,
+		OFX:         params.OFX,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(preview.Errors) > 0 {
+		return nil, importer_errors.ErrImportRowInvalid.Format(preview.Errors[0].Line, preview.Errors[0].Reason)
+	}
+
+	ids, err := s.save(ctx, preview.Account, preview.Rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportOFXResult{
+		TransactionIDs: ids,
+		Skipped:        len(preview.Skipped),
+	}, nil
+}
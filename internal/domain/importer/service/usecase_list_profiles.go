@@ -0,0 +1,44 @@
+package importer_service
+
+import (
+	"context"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/specification"
+)
+
+type ListProfilesParams struct {
+	Pagination common_service.PaginationParams
+}
+
+type ListProfilesResult struct {
+	Pagination common_service.PaginationResult
+	Profiles   importer_entity.Profiles
+}
+
+func (s *ImporterServiceImpl) ListProfiles(ctx context.Context, params *ListProfilesParams) (*ListProfilesResult, error) {
+	params.Pagination = params.Pagination.Normalize()
+
+	profiles, err := s.profileRepository.List(ctx, common_repository.ListArgs[importer_specification.ProfileSpecification]{
+		Limit:  common_specification.WithLimit(params.Pagination.Limit()),
+		Offset: common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		s.logger.Error("import profile repository list error", "detail", err.Error())
+		return nil, err
+	}
+
+	size, err := s.profileRepository.Size(ctx)
+	if err != nil {
+		s.logger.Error("import profile repository size error", "detail", err.Error())
+		return nil, err
+	}
+
+	return &ListProfilesResult{
+		Pagination: common_service.NewPaginationResult(params.Pagination, size),
+		Profiles:   profiles,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package importer_specification
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+)
+
+type ProfileSpecification interface {
+	Call(profile importer_entity.Profile) bool
+}
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(profile importer_entity.Profile) bool {
+	return profile.ID == spec.ID
+}
+
+func WithID(id uuid.UUID) ProfileSpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type NameIsSpecification struct {
+	Name string
+}
+
+func (spec NameIsSpecification) Call(profile importer_entity.Profile) bool {
+	return strings.EqualFold(profile.Name, spec.Name)
+}
+
+func NameIs(name string) ProfileSpecification {
+	return NameIsSpecification{
+		Name: name,
+	}
+}
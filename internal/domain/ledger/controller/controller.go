@@ -0,0 +1,85 @@
+package ledger_controller
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	ledger_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/service"
+)
+
+type LedgerController interface {
+	Register(*echo.Echo)
+	GetJournalEntry(c echo.Context) error
+	ListJournalEntries(c echo.Context) error
+}
+
+type LedgerControllerImpl struct {
+	logger        logger.Logger
+	ledgerService ledger_service.LedgerService
+}
+
+func (ctl *LedgerControllerImpl) Register(e *echo.Echo) {
+	e.GET("/v1/journal-entries", ctl.ListJournalEntries)
+	e.GET("/v1/journal-entries/:id", ctl.GetJournalEntry)
+}
+
+func (ctl *LedgerControllerImpl) GetJournalEntry(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.ledgerService.GetJournalEntry(c.Request().Context(), &ledger_service.GetJournalEntryParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &GetJournalEntryResponse{
+		JournalEntry: NewJournalEntryResponse(result.JournalEntry),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *LedgerControllerImpl) ListJournalEntries(c echo.Context) error {
+	params := &ledger_service.ListJournalEntriesParams{
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.ledgerService.ListJournalEntries(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListJournalEntriesResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		JournalEntries:     NewJournalEntriesResponse(result.JournalEntries),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func New(logger logger.Logger, ledgerService ledger_service.LedgerService) LedgerController {
+	return &LedgerControllerImpl{
+		logger:        logger,
+		ledgerService: ledgerService,
+	}
+}
@@ -0,0 +1,91 @@
+package ledger_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	ledger_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/entity"
+)
+
+type PostingResponse struct {
+	ID            uuid.UUID     `json:"id"`
+	TransactionID uuid.NullUUID `json:"transaction_id"`
+	Account       string        `json:"account"`
+	Amount        int64         `json:"amount"`
+	Currency      string        `json:"currency"`
+}
+
+type PostingsResponse []PostingResponse
+
+type JournalEntryResponse struct {
+	ID           uuid.UUID        `json:"id"`
+	ReversalOfID uuid.NullUUID    `json:"reversal_of_id"`
+	Description  string           `json:"description"`
+	PostedAt     time.Time        `json:"posted_at"`
+	VoidedAt     *time.Time       `json:"voided_at,omitempty"`
+	Postings     PostingsResponse `json:"postings"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+type JournalEntriesResponse []JournalEntryResponse
+
+type ListJournalEntriesResponse struct {
+	common_schema.PaginationResponse
+	JournalEntries JournalEntriesResponse `json:"journal_entries"`
+}
+
+type GetJournalEntryResponse struct {
+	JournalEntry JournalEntryResponse `json:"journal_entry"`
+}
+
+func NewPostingResponse(posting ledger_entity.Posting) PostingResponse {
+	return PostingResponse{
+		ID: posting.ID,
+		TransactionID: uuid.NullUUID{
+			UUID:  posting.TransactionID,
+			Valid: posting.TransactionID != uuid.Nil,
+		},
+		Account:  posting.Account,
+		Amount:   posting.Amount.Amount,
+		Currency: posting.Amount.Currency,
+	}
+}
+
+func NewJournalEntryResponse(entry ledger_entity.JournalEntry) JournalEntryResponse {
+	postings := PostingsResponse{}
+	for _, p := range entry.Postings {
+		postings = append(postings, NewPostingResponse(p))
+	}
+
+	var voidedAt *time.Time
+	if entry.IsVoided() {
+		voidedAt = &entry.VoidedAt
+	}
+
+	return JournalEntryResponse{
+		ID: entry.ID,
+		ReversalOfID: uuid.NullUUID{
+			UUID:  entry.ReversalOfID,
+			Valid: entry.IsReversal(),
+		},
+		Description: entry.Description,
+		PostedAt:    entry.PostedAt,
+		VoidedAt:    voidedAt,
+		Postings:    postings,
+		CreatedAt:   entry.CreatedAt,
+		UpdatedAt:   entry.UpdatedAt,
+	}
+}
+
+func NewJournalEntriesResponse(entries ledger_entity.JournalEntries) JournalEntriesResponse {
+	entriesResponse := JournalEntriesResponse{}
+
+	for _, e := range entries {
+		entriesResponse = append(entriesResponse, NewJournalEntryResponse(e))
+	}
+
+	return entriesResponse
+}
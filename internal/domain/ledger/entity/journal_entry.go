@@ -0,0 +1,95 @@
+package ledger_entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+)
+
+type JournalEntry struct {
+	ID uuid.UUID
+	// ReversalOfID points to the entry this one cancels out, when it is a
+	// reversing entry.
+	ReversalOfID uuid.UUID
+	Description  string
+	PostedAt     time.Time
+	// VoidedAt is set once a reversing entry has cancelled this one out.
+	VoidedAt  time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Postings  Postings
+}
+
+type JournalEntries []JournalEntry
+
+var NoJournalEntry = JournalEntry{}
+var NoJournalEntries = []JournalEntry{}
+
+func (e JournalEntry) IsBalanced() bool {
+	return e.Postings.IsBalanced()
+}
+
+func (e JournalEntry) IsVoided() bool {
+	return !e.VoidedAt.IsZero()
+}
+
+func (e JournalEntry) IsReversal() bool {
+	return e.ReversalOfID != uuid.Nil
+}
+
+// Reverse returns the entry cancelling out this one, dated alike so balances
+// as of any time leave both out. Its postings get their ids once posted.
+func (e JournalEntry) Reverse() JournalEntry {
+	postings := make(Postings, 0, len(e.Postings))
+	for _, posting := range e.Postings {
+		postings = append(postings, Posting{
+			TransactionID: posting.TransactionID,
+			Account:       posting.Account,
+			Amount:        posting.Amount.Neg(),
+		})
+	}
+
+	return JournalEntry{
+		ReversalOfID: e.ID,
+		Description:  e.Description,
+		PostedAt:     e.PostedAt,
+		Postings:     postings,
+	}
+}
+
+type Posting struct {
+	ID             uuid.UUID
+	JournalEntryID uuid.UUID
+	TransactionID  uuid.UUID
+	Account        string
+	Amount         common_types.Money
+	CreatedAt      time.Time
+}
+
+type Postings []Posting
+
+var NoPosting = Posting{}
+var NoPostings = []Posting{}
+
+// Sums totals the postings per currency.
+func (p Postings) Sums() map[string]int64 {
+	sums := map[string]int64{}
+	for _, posting := range p {
+		sums[posting.Amount.Currency] += posting.Amount.Amount
+	}
+
+	return sums
+}
+
+// IsBalanced reports whether the postings cancel out within every currency.
+func (p Postings) IsBalanced() bool {
+	for _, sum := range p.Sums() {
+		if sum != 0 {
+			return false
+		}
+	}
+
+	return true
+}
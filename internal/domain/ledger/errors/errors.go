@@ -0,0 +1,33 @@
+package ledger_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrJournalEntryNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "JOURNAL_ENTRY_NOT_FOUND_ERROR",
+		Message: "Journal entry not found. Please pass valid journal entry id.",
+	}
+
+	ErrJournalEntryEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "JOURNAL_ENTRY_EMPTY_ERROR",
+		Message: "Journal entry has no postings. Please pass at least two postings.",
+	}
+
+	ErrJournalEntryUnbalanced = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "JOURNAL_ENTRY_UNBALANCED_ERROR",
+		Message: "Journal entry postings do not balance to zero.",
+	}
+
+	ErrJournalEntryVoided = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "JOURNAL_ENTRY_VOIDED_ERROR",
+		Message: "Journal entry has been voided already.",
+	}
+)
@@ -0,0 +1,11 @@
+package ledger_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	ledger_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/entity"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+)
+
+type JournalEntryRepository common_repository.Repository[ledger_entity.JournalEntry, ledger_specification.JournalEntrySpecification]
+
+type PostingRepository common_repository.Repository[ledger_entity.Posting, ledger_specification.PostingSpecification]
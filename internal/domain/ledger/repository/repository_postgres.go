@@ -0,0 +1,205 @@
+package ledger_repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+
+	ledger_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/entity"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+)
+
+type PostgresJournalEntryRow struct {
+	ID           uuid.UUID
+	ReversalOfID uuid.NullUUID
+	Description  string
+	PostedAt     time.Time
+	VoidedAt     sql.NullTime
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type PostgresPostingRow struct {
+	ID             uuid.UUID
+	JournalEntryID uuid.UUID
+	TransactionID  uuid.NullUUID
+	Account        string
+	Amount         int64
+	Currency       string
+	CreatedAt      time.Time
+}
+
+func NewPostgresJournalEntryRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (JournalEntryRepository, error) {
+	return postgres_repository.New[ledger_entity.JournalEntry, ledger_specification.JournalEntrySpecification, *PostgresJournalEntryRow](postgres_repository.Option[ledger_entity.JournalEntry, ledger_specification.JournalEntrySpecification, *PostgresJournalEntryRow]{
+		Logger:    logger,
+		TableName: "journal_entries",
+		Schema: map[string]string{
+			"id":             postgres_repository.UUID,
+			"reversal_of_id": postgres_repository.UUID,
+			"description":    postgres_repository.CharacterVarying,
+			"posted_at":      postgres_repository.TimestampWithZone,
+			"voided_at":      postgres_repository.TimestampWithZone,
+			"created_at":     postgres_repository.TimestampWithZone,
+			"updated_at":     postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"reversal_of_id",
+			"description",
+			"posted_at",
+			"voided_at",
+			"created_at",
+			"updated_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...ledger_specification.JournalEntrySpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case ledger_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresJournalEntryRow, error) {
+			row := &PostgresJournalEntryRow{}
+			if err := rows.Scan(&row.ID, &row.ReversalOfID, &row.Description, &row.PostedAt, &row.VoidedAt, &row.CreatedAt, &row.UpdatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresJournalEntryRow) ledger_entity.JournalEntry {
+			return ledger_entity.JournalEntry{
+				ID:           row.ID,
+				ReversalOfID: row.ReversalOfID.UUID,
+				Description:  row.Description,
+				PostedAt:     row.PostedAt,
+				VoidedAt:     row.VoidedAt.Time,
+				CreatedAt:    row.CreatedAt,
+				UpdatedAt:    row.UpdatedAt,
+			}
+		},
+		Row: func(entry ledger_entity.JournalEntry) *PostgresJournalEntryRow {
+			return &PostgresJournalEntryRow{
+				ID: entry.ID,
+				ReversalOfID: uuid.NullUUID{
+					UUID:  entry.ReversalOfID,
+					Valid: entry.ReversalOfID != uuid.Nil,
+				},
+				Description: entry.Description,
+				PostedAt:    entry.PostedAt,
+				VoidedAt: sql.NullTime{
+					Time:  entry.VoidedAt,
+					Valid: entry.IsVoided(),
+				},
+				CreatedAt: entry.CreatedAt,
+				UpdatedAt: entry.UpdatedAt,
+			}
+		},
+		Values: func(row *PostgresJournalEntryRow) []any {
+			return []any{
+				row.ID,
+				row.ReversalOfID,
+				row.Description,
+				row.PostedAt,
+				row.VoidedAt,
+				row.CreatedAt,
+				row.UpdatedAt,
+			}
+		},
+	})
+}
+
+func NewPostgresPostingRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (PostingRepository, error) {
+	return postgres_repository.New[ledger_entity.Posting, ledger_specification.PostingSpecification, *PostgresPostingRow](postgres_repository.Option[ledger_entity.Posting, ledger_specification.PostingSpecification, *PostgresPostingRow]{
+		Logger:    logger,
+		TableName: "postings",
+		Schema: map[string]string{
+			"id":               postgres_repository.UUID,
+			"journal_entry_id": postgres_repository.UUID,
+			"transaction_id":   postgres_repository.UUID,
+			"account":          postgres_repository.CharacterVarying,
+			"amount":           postgres_repository.BigInt,
+			"currency":         postgres_repository.Character,
+			"created_at":       postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"journal_entry_id",
+			"transaction_id",
+			"account",
+			"amount",
+			"currency",
+			"created_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...ledger_specification.PostingSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case ledger_specification.JournalEntryIsSpecification:
+					where = append(where, squirrel.Eq{"journal_entry_id": v.JournalEntryID})
+				case ledger_specification.AccountIsSpecification:
+					where = append(where, squirrel.Eq{"account": v.Account})
+				case ledger_specification.PostedBeforeSpecification:
+					where = append(where, squirrel.Expr("journal_entry_id IN (SELECT id FROM journal_entries WHERE posted_at <= ?)", v.Time))
+				case ledger_specification.TransactionInSpecification:
+					where = append(where, squirrel.Eq{"transaction_id": v.TransactionIDs})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresPostingRow, error) {
+			row := &PostgresPostingRow{}
+			if err := rows.Scan(&row.ID, &row.JournalEntryID, &row.TransactionID, &row.Account, &row.Amount, &row.Currency, &row.CreatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresPostingRow) ledger_entity.Posting {
+			return ledger_entity.Posting{
+				ID:             row.ID,
+				JournalEntryID: row.JournalEntryID,
+				TransactionID:  row.TransactionID.UUID,
+				Account:        row.Account,
+				Amount:         common_types.NewMoney(row.Amount, row.Currency),
+				CreatedAt:      row.CreatedAt,
+			}
+		},
+		Row: func(posting ledger_entity.Posting) *PostgresPostingRow {
+			return &PostgresPostingRow{
+				ID:             posting.ID,
+				JournalEntryID: posting.JournalEntryID,
+				TransactionID: uuid.NullUUID{
+					UUID:  posting.TransactionID,
+					Valid: posting.TransactionID != uuid.Nil,
+				},
+				Account:   posting.Account,
+				Amount:    posting.Amount.Amount,
+				Currency:  posting.Amount.Currency,
+				CreatedAt: posting.CreatedAt,
+			}
+		},
+		Values: func(row *PostgresPostingRow) []any {
+			return []any{
+				row.ID,
+				row.JournalEntryID,
+				row.TransactionID,
+				row.Account,
+				row.Amount,
+				row.Currency,
+				row.CreatedAt,
+			}
+		},
+	})
+}
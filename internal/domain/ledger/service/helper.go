@@ -0,0 +1,99 @@
+package ledger_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	ledger_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/entity"
+	ledger_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/errors"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+func (s *LedgerServiceImpl) getPostings(ctx context.Context, journalEntryID uuid.UUID) (ledger_entity.Postings, error) {
+	return s.postingRepository.List(ctx, common_repository.ListArgs[ledger_specification.PostingSpecification]{
+		Filters: []ledger_specification.PostingSpecification{ledger_specification.JournalEntryIs(journalEntryID)},
+	})
+}
+
+// checkBalance re-reads the persisted postings, so anything written to the
+// entry within the same database transaction is taken into account.
+func (s *LedgerServiceImpl) checkBalance(ctx context.Context, journalEntryID uuid.UUID) error {
+	postings, err := s.getPostings(ctx, journalEntryID)
+	if err != nil {
+		return err
+	}
+
+	if !postings.IsBalanced() {
+		s.logger.Error("ledger/UNBALANCED", "journal_entry_id", journalEntryID.String(), "sums", postings.Sums())
+		return ledger_errors.ErrJournalEntryUnbalanced
+	}
+
+	return nil
+}
+
+// post saves a new journal entry together with its postings, checking the
+// balance again before the database transaction commits.
+func (s *LedgerServiceImpl) post(ctx context.Context, entry ledger_entity.JournalEntry, now time.Time) (ledger_entity.JournalEntry, error) {
+	if entry.ID == uuid.Nil {
+		entry.ID = uuid.New()
+	}
+
+	if !exists.Date(entry.PostedAt) {
+		entry.PostedAt = now
+	}
+
+	entry.CreatedAt = now
+	entry.UpdatedAt = now
+
+	postings := make(ledger_entity.Postings, 0, len(entry.Postings))
+	for _, posting := range entry.Postings {
+		if posting.ID == uuid.Nil {
+			posting.ID = uuid.New()
+		}
+
+		posting.JournalEntryID = entry.ID
+		posting.CreatedAt = now
+		postings = append(postings, posting)
+	}
+	entry.Postings = postings
+
+	if err := s.journalEntryRepository.Save(ctx, entry); err != nil {
+		return entry, err
+	}
+
+	for _, posting := range entry.Postings {
+		if err := s.postingRepository.Save(ctx, posting); err != nil {
+			return entry, err
+		}
+	}
+
+	return entry, s.transactionManager.BeforeCommit(ctx, func(ctx context.Context) error {
+		return s.checkBalance(ctx, entry.ID)
+	})
+}
+
+// void posts the reversing entry of the journal entry and marks it voided.
+func (s *LedgerServiceImpl) void(ctx context.Context, entry ledger_entity.JournalEntry, now time.Time) error {
+	if entry.IsVoided() {
+		return ledger_errors.ErrJournalEntryVoided
+	}
+
+	postings, err := s.getPostings(ctx, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	entry.Postings = postings
+	if _, err := s.post(ctx, entry.Reverse(), now); err != nil {
+		return err
+	}
+
+	entry.VoidedAt = now
+	entry.UpdatedAt = now
+
+	return s.journalEntryRepository.Save(ctx, entry)
+}
@@ -0,0 +1,37 @@
+package ledger_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
+
+	ledger_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/repository"
+)
+
+type LedgerService interface {
+	PostJournalEntry(ctx context.Context, params *PostJournalEntryParams) (*PostJournalEntryResult, error)
+	VoidJournalEntry(ctx context.Context, params *VoidJournalEntryParams) (*VoidJournalEntryResult, error)
+	GetJournalEntry(ctx context.Context, params *GetJournalEntryParams) (*GetJournalEntryResult, error)
+	ListJournalEntries(ctx context.Context, params *ListJournalEntriesParams) (*ListJournalEntriesResult, error)
+}
+
+type LedgerServiceImpl struct {
+	journalEntryRepository ledger_repository.JournalEntryRepository
+	postingRepository      ledger_repository.PostingRepository
+	transactionManager     transaction_manager.TransactionManager
+	logger                 logger.Logger
+}
+
+func New(
+	logger logger.Logger,
+	journalEntryRepository ledger_repository.JournalEntryRepository,
+	postingRepository ledger_repository.PostingRepository,
+	transactionManager transaction_manager.TransactionManager) LedgerService {
+	return &LedgerServiceImpl{
+		journalEntryRepository: journalEntryRepository,
+		postingRepository:      postingRepository,
+		transactionManager:     transactionManager,
+		logger:                 logger,
+	}
+}
@@ -0,0 +1,39 @@
+package ledger_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	ledger_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/entity"
+	ledger_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/errors"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+)
+
+type GetJournalEntryParams struct {
+	ID uuid.UUID
+}
+
+type GetJournalEntryResult struct {
+	JournalEntry ledger_entity.JournalEntry
+}
+
+func (s *LedgerServiceImpl) GetJournalEntry(ctx context.Context, params *GetJournalEntryParams) (*GetJournalEntryResult, error) {
+	entry, err := s.journalEntryRepository.Get(ctx, ledger_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.ID == uuid.Nil {
+		return nil, ledger_errors.ErrJournalEntryNotFound
+	}
+
+	entry.Postings, err = s.getPostings(ctx, entry.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetJournalEntryResult{
+		JournalEntry: entry,
+	}, nil
+}
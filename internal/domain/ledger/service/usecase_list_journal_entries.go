@@ -0,0 +1,49 @@
+package ledger_service
+
+import (
+	"context"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	ledger_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/entity"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+)
+
+type ListJournalEntriesParams struct {
+	Pagination common_service.PaginationParams
+}
+
+type ListJournalEntriesResult struct {
+	Pagination     common_service.PaginationResult
+	JournalEntries []ledger_entity.JournalEntry
+}
+
+func (s *LedgerServiceImpl) ListJournalEntries(ctx context.Context, params *ListJournalEntriesParams) (*ListJournalEntriesResult, error) {
+	params.Pagination = params.Pagination.Normalize()
+
+	entries, err := s.journalEntryRepository.List(ctx, common_repository.ListArgs[ledger_specification.JournalEntrySpecification]{
+		Limit:  common_specification.WithLimit(params.Pagination.Limit()),
+		Offset: common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		entries[i].Postings, err = s.getPostings(ctx, entries[i].ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	size, err := s.journalEntryRepository.Size(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListJournalEntriesResult{
+		Pagination:     common_service.NewPaginationResult(params.Pagination, size),
+		JournalEntries: entries,
+	}, nil
+}
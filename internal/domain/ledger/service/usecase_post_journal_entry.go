@@ -0,0 +1,69 @@
+package ledger_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	ledger_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/entity"
+	ledger_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/errors"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+)
+
+type PostJournalEntryParams struct {
+	JournalEntry ledger_entity.JournalEntry
+}
+
+type PostJournalEntryResult struct {
+	JournalEntry ledger_entity.JournalEntry
+}
+
+// PostJournalEntry creates the journal entry. An entry posted before is never
+// rewritten: it is voided by a reversing entry and the postings go to a new
+// entry, returned in its place. The balance is checked up front and once more
+// right before the surrounding database transaction commits.
+func (s *LedgerServiceImpl) PostJournalEntry(ctx context.Context, params *PostJournalEntryParams) (*PostJournalEntryResult, error) {
+	entry := params.JournalEntry
+
+	if len(entry.Postings) < 2 {
+		return nil, ledger_errors.ErrJournalEntryEmpty
+	}
+
+	if !entry.IsBalanced() {
+		return nil, ledger_errors.ErrJournalEntryUnbalanced
+	}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		now := time.Now()
+
+		if entry.ID != uuid.Nil {
+			existing, err := s.journalEntryRepository.Get(ctx, ledger_specification.WithID(entry.ID))
+			if err != nil {
+				return err
+			}
+
+			if existing.ID != uuid.Nil {
+				if err := s.void(ctx, existing, now); err != nil {
+					return err
+				}
+
+				entry.ID = uuid.Nil
+			}
+		}
+
+		posted, err := s.post(ctx, entry, now)
+		if err != nil {
+			return err
+		}
+
+		entry = posted
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &PostJournalEntryResult{
+		JournalEntry: entry,
+	}, nil
+}
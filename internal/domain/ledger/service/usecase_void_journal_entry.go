@@ -0,0 +1,38 @@
+package ledger_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	ledger_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/errors"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+)
+
+type VoidJournalEntryParams struct {
+	ID uuid.UUID
+}
+
+type VoidJournalEntryResult struct{}
+
+// VoidJournalEntry cancels the journal entry out with a reversing entry. Both
+// are kept along with their postings, so the ledger shows what was voided.
+func (s *LedgerServiceImpl) VoidJournalEntry(ctx context.Context, params *VoidJournalEntryParams) (*VoidJournalEntryResult, error) {
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		entry, err := s.journalEntryRepository.Get(ctx, ledger_specification.WithID(params.ID))
+		if err != nil {
+			return err
+		}
+
+		if entry.ID == uuid.Nil {
+			return ledger_errors.ErrJournalEntryNotFound
+		}
+
+		return s.void(ctx, entry, time.Now())
+	}); err != nil {
+		return nil, err
+	}
+
+	return &VoidJournalEntryResult{}, nil
+}
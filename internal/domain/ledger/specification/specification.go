@@ -0,0 +1,91 @@
+package ledger_specification
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	ledger_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/entity"
+)
+
+type JournalEntrySpecification interface {
+	Call(entry ledger_entity.JournalEntry) bool
+}
+
+type PostingSpecification interface {
+	Call(posting ledger_entity.Posting) bool
+}
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(entry ledger_entity.JournalEntry) bool {
+	return spec.ID == entry.ID
+}
+
+func WithID(id uuid.UUID) JournalEntrySpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type JournalEntryIsSpecification struct {
+	JournalEntryID uuid.UUID
+}
+
+func (spec JournalEntryIsSpecification) Call(posting ledger_entity.Posting) bool {
+	return spec.JournalEntryID == posting.JournalEntryID
+}
+
+func JournalEntryIs(id uuid.UUID) PostingSpecification {
+	return JournalEntryIsSpecification{
+		JournalEntryID: id,
+	}
+}
+
+type AccountIsSpecification struct {
+	Account string
+}
+
+func (spec AccountIsSpecification) Call(posting ledger_entity.Posting) bool {
+	return spec.Account == posting.Account
+}
+
+func AccountIs(account string) PostingSpecification {
+	return AccountIsSpecification{
+		Account: account,
+	}
+}
+
+// PostedBeforeSpecification matches the postings of the journal entries posted
+// at or before the time. The posting date lives on the entry, so it can only
+// be checked by the repository.
+type PostedBeforeSpecification struct {
+	Time time.Time
+}
+
+func (spec PostedBeforeSpecification) Call(posting ledger_entity.Posting) bool {
+	return false
+}
+
+func PostedBefore(t time.Time) PostingSpecification {
+	return PostedBeforeSpecification{
+		Time: t,
+	}
+}
+
+type TransactionInSpecification struct {
+	TransactionIDs []uuid.UUID
+}
+
+func (spec TransactionInSpecification) Call(posting ledger_entity.Posting) bool {
+	return slices.Contains(spec.TransactionIDs, posting.TransactionID)
+}
+
+func TransactionIn(ids ...uuid.UUID) PostingSpecification {
+	return TransactionInSpecification{
+		TransactionIDs: ids,
+	}
+}
@@ -0,0 +1,16 @@
+package ledger_types
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const (
+	Income   = "Income"
+	Expenses = "Expenses"
+)
+
+func Assets(accountID uuid.UUID) string {
+	return fmt.Sprintf("Assets:%s", accountID)
+}
@@ -0,0 +1,166 @@
+package payee_controller
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	payee_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/service"
+)
+
+type PayeeController interface {
+	Register(*echo.Echo)
+	CreatePayee(c echo.Context) error
+	GetPayee(c echo.Context) error
+	ListPayees(c echo.Context) error
+	UpdatePayee(c echo.Context) error
+	DeletePayee(c echo.Context) error
+}
+
+type PayeeControllerImpl struct {
+	logger       logger.Logger
+	payeeService payee_service.PayeeService
+}
+
+func (ctl *PayeeControllerImpl) Register(e *echo.Echo) {
+	e.POST("/v1/payees", ctl.CreatePayee)
+	e.GET("/v1/payees", ctl.ListPayees)
+	e.GET("/v1/payees/:id", ctl.GetPayee)
+	e.PATCH("/v1/payees/:id", ctl.UpdatePayee)
+	e.DELETE("/v1/payees/:id", ctl.DeletePayee)
+}
+
+func (ctl *PayeeControllerImpl) CreatePayee(c echo.Context) error {
+	requestJSON := &CreatePayeeRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.payeeService.CreatePayee(c.Request().Context(), &payee_service.CreatePayeeParams{
+		Name:    requestJSON.Payee.Name,
+		Aliases: requestJSON.Payee.Aliases,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &CreatePayeeResponse{
+		Payee: NewPayeeResponse(result.Payee, result.Aliases),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (ctl *PayeeControllerImpl) GetPayee(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.payeeService.GetPayee(c.Request().Context(), &payee_service.GetPayeeParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &GetPayeeResponse{
+		Payee: NewPayeeResponse(result.Payee, result.Aliases),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *PayeeControllerImpl) ListPayees(c echo.Context) error {
+	params := &payee_service.ListPayeesParams{
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		String("name_like", &params.NameLike).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.payeeService.ListPayees(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListPayeesResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Payees:             NewPayeesResponse(result.Payees, result.Aliases),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *PayeeControllerImpl) UpdatePayee(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &UpdatePayeeRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	params := &payee_service.UpdatePayeeParams{
+		ID: id,
+	}
+
+	if requestJSON.Payee.Name != nil {
+		params.Name = common_types.Maybe[string]{Present: true, Value: *requestJSON.Payee.Name}
+	}
+
+	if requestJSON.Payee.Aliases != nil {
+		params.Aliases = common_types.Maybe[[]string]{Present: true, Value: *requestJSON.Payee.Aliases}
+	}
+
+	result, err := ctl.payeeService.UpdatePayee(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &UpdatePayeeResponse{
+		Payee: NewPayeeResponse(result.Payee, result.Aliases),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *PayeeControllerImpl) DeletePayee(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	if _, err := ctl.payeeService.DeletePayee(c.Request().Context(), &payee_service.DeletePayeeParams{
+		ID: id,
+	}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func New(logger logger.Logger, payeeService payee_service.PayeeService) PayeeController {
+	return &PayeeControllerImpl{
+		logger:       logger,
+		payeeService: payeeService,
+	}
+}
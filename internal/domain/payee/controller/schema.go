@@ -0,0 +1,75 @@
+package payee_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+)
+
+type PayeeResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Aliases   []string  `json:"aliases"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PayeesResponse []PayeeResponse
+
+type ListPayeesResponse struct {
+	common_schema.PaginationResponse
+	Payees PayeesResponse `json:"payees"`
+}
+
+type PayeeRequest struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+type CreatePayeeRequest struct {
+	Payee PayeeRequest `json:"payee"`
+}
+
+type CreatePayeeResponse struct {
+	Payee PayeeResponse `json:"payee"`
+}
+
+type UpdatePayeeFieldsRequest struct {
+	Name    *string   `json:"name"`
+	Aliases *[]string `json:"aliases"`
+}
+
+type UpdatePayeeRequest struct {
+	Payee UpdatePayeeFieldsRequest `json:"payee"`
+}
+
+type UpdatePayeeResponse struct {
+	Payee PayeeResponse `json:"payee"`
+}
+
+type GetPayeeResponse struct {
+	Payee PayeeResponse `json:"payee"`
+}
+
+func NewPayeeResponse(payee payee_entity.Payee, aliases payee_entity.Aliases) PayeeResponse {
+	return PayeeResponse{
+		ID:        payee.ID,
+		Name:      payee.Name,
+		Aliases:   aliases.Patterns(),
+		CreatedAt: payee.CreatedAt,
+		UpdatedAt: payee.UpdatedAt,
+	}
+}
+
+func NewPayeesResponse(payees payee_entity.Payees, aliases map[uuid.UUID]payee_entity.Aliases) PayeesResponse {
+	payeesResponse := PayeesResponse{}
+
+	for _, p := range payees {
+		payeesResponse = append(payeesResponse, NewPayeeResponse(p, aliases[p.ID]))
+	}
+
+	return payeesResponse
+}
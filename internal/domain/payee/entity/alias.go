@@ -0,0 +1,74 @@
+package payee_entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Alias is a pattern matched against transaction descriptions to recognize
+// the payee. Patterns match the whole description regardless of case, and
+// every * stands for any run of characters, e.g. "*starbucks*".
+type Alias struct {
+	ID        uuid.UUID
+	PayeeID   uuid.UUID
+	Pattern   string
+	CreatedAt time.Time
+}
+
+type Aliases []Alias
+
+var NoAlias = Alias{}
+var NoAliases = []Alias{}
+
+// NormalizePattern trims the pattern and collapses its inner whitespace.
+func NormalizePattern(pattern string) string {
+	return strings.Join(strings.Fields(pattern), " ")
+}
+
+func (a Alias) Match(description string) bool {
+	parts := strings.Split(strings.ToLower(NormalizePattern(a.Pattern)), "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	pattern := regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+	return pattern.MatchString(strings.ToLower(NormalizePattern(description)))
+}
+
+// Specificity counts the literal characters of the pattern, the more of them
+// the narrower the pattern.
+func (a Alias) Specificity() int {
+	return len(strings.ReplaceAll(NormalizePattern(a.Pattern), "*", ""))
+}
+
+func (aliases Aliases) Patterns() []string {
+	patterns := []string{}
+	for _, alias := range aliases {
+		patterns = append(patterns, alias.Pattern)
+	}
+
+	return patterns
+}
+
+// Resolve returns the payee of the most specific alias matching the
+// description, ties going to the oldest alias, or uuid.Nil if none matches.
+func (aliases Aliases) Resolve(description string) uuid.UUID {
+	var found Alias
+
+	for _, alias := range aliases {
+		if !alias.Match(description) {
+			continue
+		}
+
+		if found == NoAlias ||
+			alias.Specificity() > found.Specificity() ||
+			(alias.Specificity() == found.Specificity() && alias.CreatedAt.Before(found.CreatedAt)) {
+			found = alias
+		}
+	}
+
+	return found.PayeeID
+}
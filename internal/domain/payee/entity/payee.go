@@ -0,0 +1,21 @@
+package payee_entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Payee is the merchant or person on the other side of a transaction, under
+// one canonical name however the bank spells it.
+type Payee struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Payees []Payee
+
+var NoPayee = Payee{}
+var NoPayees = []Payee{}
@@ -0,0 +1,39 @@
+package payee_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrPayeeNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "PAYEE_NOT_FOUND_ERROR",
+		Message: "Payee not found. Please pass valid payee id.",
+	}
+
+	ErrPayeeAlreadyExist = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "PAYEE_ALREADY_EXIST_ERROR",
+		Message: "Payee already exists. Please use different name.",
+	}
+
+	ErrPayeeNameEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "PAYEE_NAME_EMPTY_ERROR",
+		Message: "Payee name is empty. Please pass non-empty name.",
+	}
+
+	ErrPayeeAliasEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "PAYEE_ALIAS_EMPTY_ERROR",
+		Message: "Payee alias is empty. Please pass a pattern with at least one character other than *.",
+	}
+
+	ErrPayeeAliasAlreadyExist = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "PAYEE_ALIAS_ALREADY_EXIST_ERROR",
+		Template: "Payee alias '%s' already belongs to another payee. Please remove it there first.",
+	}
+)
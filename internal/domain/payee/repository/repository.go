@@ -0,0 +1,12 @@
+package payee_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	payee_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/specification"
+)
+
+type PayeeRepository common_repository.Repository[payee_entity.Payee, payee_specification.PayeeSpecification]
+
+type AliasRepository common_repository.Repository[payee_entity.Alias, payee_specification.AliasSpecification]
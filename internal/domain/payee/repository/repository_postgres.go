@@ -0,0 +1,93 @@
+package payee_repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	payee_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/specification"
+)
+
+type PostgresPayeeRow struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (PayeeRepository, error) {
+	return postgres_repository.New[payee_entity.Payee, payee_specification.PayeeSpecification, *PostgresPayeeRow](postgres_repository.Option[payee_entity.Payee, payee_specification.PayeeSpecification, *PostgresPayeeRow]{
+		Logger:    logger,
+		TableName: "payees",
+		Schema: map[string]string{
+			"id":         postgres_repository.UUID,
+			"name":       postgres_repository.CharacterVarying,
+			"created_at": postgres_repository.TimestampWithZone,
+			"updated_at": postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"name",
+			"created_at",
+			"updated_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...payee_specification.PayeeSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case payee_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				case payee_specification.WithoutIDSpecification:
+					where = append(where, squirrel.NotEq{"id": v.ID})
+				case payee_specification.IDInSpecification:
+					where = append(where, squirrel.Eq{"id": v.IDs})
+				case payee_specification.NameIsSpecification:
+					where = append(where, squirrel.Expr("LOWER(name) = LOWER(?)", v.Name))
+				case payee_specification.NameLikeSpecification:
+					where = append(where, squirrel.ILike{"name": "%" + v.Substring + "%"})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresPayeeRow, error) {
+			row := &PostgresPayeeRow{}
+			if err := rows.Scan(&row.ID, &row.Name, &row.CreatedAt, &row.UpdatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresPayeeRow) payee_entity.Payee {
+			return payee_entity.Payee{
+				ID:        row.ID,
+				Name:      row.Name,
+				CreatedAt: row.CreatedAt,
+				UpdatedAt: row.UpdatedAt,
+			}
+		},
+		Row: func(payee payee_entity.Payee) *PostgresPayeeRow {
+			return &PostgresPayeeRow{
+				ID:        payee.ID,
+				Name:      payee.Name,
+				CreatedAt: payee.CreatedAt,
+				UpdatedAt: payee.UpdatedAt,
+			}
+		},
+		Values: func(row *PostgresPayeeRow) []any {
+			return []any{
+				row.ID,
+				row.Name,
+				row.CreatedAt,
+				row.UpdatedAt,
+			}
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package payee_repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	payee_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/specification"
+)
+
+type PostgresAliasRow struct {
+	ID        uuid.UUID
+	PayeeID   uuid.UUID
+	Pattern   string
+	CreatedAt time.Time
+}
+
+func NewPostgresAliasRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (AliasRepository, error) {
+	return postgres_repository.New[payee_entity.Alias, payee_specification.AliasSpecification, *PostgresAliasRow](postgres_repository.Option[payee_entity.Alias, payee_specification.AliasSpecification, *PostgresAliasRow]{
+		Logger:    logger,
+		TableName: "payee_aliases",
+		Schema: map[string]string{
+			"id":         postgres_repository.UUID,
+			"payee_id":   postgres_repository.UUID,
+			"pattern":    postgres_repository.CharacterVarying,
+			"created_at": postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"payee_id",
+			"pattern",
+			"created_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...payee_specification.AliasSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case payee_specification.PayeeIsSpecification:
+					where = append(where, squirrel.Eq{"payee_id": v.PayeeID})
+				case payee_specification.PayeeInSpecification:
+					where = append(where, squirrel.Eq{"payee_id": v.PayeeIDs})
+				case payee_specification.PayeeIsNotSpecification:
+					where = append(where, squirrel.NotEq{"payee_id": v.PayeeID})
+				case payee_specification.PatternIsSpecification:
+					where = append(where, squirrel.Expr("LOWER(pattern) = LOWER(?)", v.Pattern))
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresAliasRow, error) {
+			row := &PostgresAliasRow{}
+			if err := rows.Scan(&row.ID, &row.PayeeID, &row.Pattern, &row.CreatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresAliasRow) payee_entity.Alias {
+			return payee_entity.Alias{
+				ID:        row.ID,
+				PayeeID:   row.PayeeID,
+				Pattern:   row.Pattern,
+				CreatedAt: row.CreatedAt,
+			}
+		},
+		Row: func(alias payee_entity.Alias) *PostgresAliasRow {
+			return &PostgresAliasRow{
+				ID:        alias.ID,
+				PayeeID:   alias.PayeeID,
+				Pattern:   alias.Pattern,
+				CreatedAt: alias.CreatedAt,
+			}
+		},
+		Values: func(row *PostgresAliasRow) []any {
+			return []any{
+				row.ID,
+				row.PayeeID,
+				row.Pattern,
+				row.CreatedAt,
+			}
+		},
+	})
+}
@@ -0,0 +1,129 @@
+package payee_service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	payee_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/errors"
+	payee_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/specification"
+)
+
+func (s *PayeeServiceImpl) getPayee(ctx context.Context, id uuid.UUID) (payee_entity.Payee, error) {
+	payee, err := s.payeeRepository.Get(ctx, payee_specification.WithID(id))
+	if err != nil {
+		return payee_entity.NoPayee, err
+	}
+
+	if payee == payee_entity.NoPayee {
+		return payee_entity.NoPayee, payee_errors.ErrPayeeNotFound
+	}
+
+	return payee, nil
+}
+
+func (s *PayeeServiceImpl) checkName(ctx context.Context, payee payee_entity.Payee) error {
+	if payee.Name == "" {
+		return payee_errors.ErrPayeeNameEmpty
+	}
+
+	exist, err := s.payeeRepository.Exist(ctx, payee_specification.NameIs(payee.Name), payee_specification.WithoutID(payee.ID))
+	if err != nil {
+		return err
+	}
+
+	if exist {
+		return payee_errors.ErrPayeeAlreadyExist
+	}
+
+	return nil
+}
+
+// newAliases normalizes the patterns and drops duplicates.
+func newAliases(payee payee_entity.Payee, patterns []string) (payee_entity.Aliases, error) {
+	aliases := payee_entity.Aliases{}
+	seen := map[string]bool{}
+	now := time.Now()
+
+	for _, pattern := range patterns {
+		pattern = payee_entity.NormalizePattern(pattern)
+		if strings.Trim(pattern, "*") == "" {
+			return nil, payee_errors.ErrPayeeAliasEmpty
+		}
+
+		if seen[strings.ToLower(pattern)] {
+			continue
+		}
+
+		seen[strings.ToLower(pattern)] = true
+		aliases = append(aliases, payee_entity.Alias{
+			ID:        uuid.New(),
+			PayeeID:   payee.ID,
+			Pattern:   pattern,
+			CreatedAt: now,
+		})
+	}
+
+	return aliases, nil
+}
+
+// setAliases replaces the aliases of the payee. A pattern can only belong to
+// one payee, otherwise resolving a description would be ambiguous.
+func (s *PayeeServiceImpl) setAliases(ctx context.Context, payee payee_entity.Payee, aliases payee_entity.Aliases) error {
+	return s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		for _, alias := range aliases {
+			taken, err := s.aliasRepository.Exist(ctx, payee_specification.PatternIs(alias.Pattern), payee_specification.PayeeIsNot(payee.ID))
+			if err != nil {
+				return err
+			}
+
+			if taken {
+				return payee_errors.ErrPayeeAliasAlreadyExist.Format(alias.Pattern)
+			}
+		}
+
+		if err := s.aliasRepository.Delete(ctx, payee_specification.PayeeIs(payee.ID)); err != nil {
+			return err
+		}
+
+		for _, alias := range aliases {
+			if err := s.aliasRepository.Save(ctx, alias); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *PayeeServiceImpl) getAliases(ctx context.Context, payees ...payee_entity.Payee) (map[uuid.UUID]payee_entity.Aliases, error) {
+	aliasesByID := map[uuid.UUID]payee_entity.Aliases{}
+	if len(payees) == 0 {
+		return aliasesByID, nil
+	}
+
+	ids := []uuid.UUID{}
+	for _, payee := range payees {
+		ids = append(ids, payee.ID)
+		aliasesByID[payee.ID] = payee_entity.Aliases{}
+	}
+
+	aliases, err := s.aliasRepository.List(ctx, common_repository.ListArgs[payee_specification.AliasSpecification]{
+		Filters: []payee_specification.AliasSpecification{
+			payee_specification.PayeeIn(ids...),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, alias := range aliases {
+		aliasesByID[alias.PayeeID] = append(aliasesByID[alias.PayeeID], alias)
+	}
+
+	return aliasesByID, nil
+}
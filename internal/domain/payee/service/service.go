@@ -0,0 +1,34 @@
+package payee_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
+
+	payee_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/repository"
+)
+
+type PayeeService interface {
+	CreatePayee(ctx context.Context, params *CreatePayeeParams) (*CreatePayeeResult, error)
+	GetPayee(ctx context.Context, params *GetPayeeParams) (*GetPayeeResult, error)
+	ListPayees(ctx context.Context, params *ListPayeesParams) (*ListPayeesResult, error)
+	UpdatePayee(ctx context.Context, params *UpdatePayeeParams) (*UpdatePayeeResult, error)
+	DeletePayee(ctx context.Context, params *DeletePayeeParams) (*DeletePayeeResult, error)
+}
+
+type PayeeServiceImpl struct {
+	logger             logger.Logger
+	payeeRepository    payee_repository.PayeeRepository
+	aliasRepository    payee_repository.AliasRepository
+	transactionManager transaction_manager.TransactionManager
+}
+
+func New(logger logger.Logger, payeeRepository payee_repository.PayeeRepository, aliasRepository payee_repository.AliasRepository, transactionManager transaction_manager.TransactionManager) PayeeService {
+	return &PayeeServiceImpl{
+		logger:             logger,
+		payeeRepository:    payeeRepository,
+		aliasRepository:    aliasRepository,
+		transactionManager: transactionManager,
+	}
+}
@@ -0,0 +1,55 @@
+package payee_service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+)
+
+type CreatePayeeParams struct {
+	Name    string
+	Aliases []string
+}
+
+type CreatePayeeResult struct {
+	Payee   payee_entity.Payee
+	Aliases payee_entity.Aliases
+}
+
+func (s *PayeeServiceImpl) CreatePayee(ctx context.Context, params *CreatePayeeParams) (*CreatePayeeResult, error) {
+	now := time.Now()
+	payee := payee_entity.Payee{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(params.Name),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.checkName(ctx, payee); err != nil {
+		return nil, err
+	}
+
+	aliases, err := newAliases(payee, params.Aliases)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		if err := s.payeeRepository.Save(ctx, payee); err != nil {
+			return err
+		}
+
+		return s.setAliases(ctx, payee, aliases)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &CreatePayeeResult{
+		Payee:   payee,
+		Aliases: aliases,
+	}, nil
+}
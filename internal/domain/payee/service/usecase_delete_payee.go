@@ -0,0 +1,30 @@
+package payee_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	payee_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/specification"
+)
+
+type DeletePayeeParams struct {
+	ID uuid.UUID
+}
+
+type DeletePayeeResult struct{}
+
+// DeletePayee removes the payee and its aliases, transactions paid to it are
+// kept without a payee.
+func (s *PayeeServiceImpl) DeletePayee(ctx context.Context, params *DeletePayeeParams) (*DeletePayeeResult, error) {
+	payee, err := s.getPayee(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.payeeRepository.Delete(ctx, payee_specification.WithID(payee.ID)); err != nil {
+		return nil, err
+	}
+
+	return &DeletePayeeResult{}, nil
+}
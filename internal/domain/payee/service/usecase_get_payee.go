@@ -0,0 +1,35 @@
+package payee_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+)
+
+type GetPayeeParams struct {
+	ID uuid.UUID
+}
+
+type GetPayeeResult struct {
+	Payee   payee_entity.Payee
+	Aliases payee_entity.Aliases
+}
+
+func (s *PayeeServiceImpl) GetPayee(ctx context.Context, params *GetPayeeParams) (*GetPayeeResult, error) {
+	payee, err := s.getPayee(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := s.getAliases(ctx, payee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetPayeeResult{
+		Payee:   payee,
+		Aliases: aliases[payee.ID],
+	}, nil
+}
@@ -0,0 +1,62 @@
+package payee_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	payee_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type ListPayeesParams struct {
+	NameLike   string
+	Pagination common_service.PaginationParams
+}
+
+type ListPayeesResult struct {
+	Pagination common_service.PaginationResult
+	Payees     payee_entity.Payees
+	Aliases    map[uuid.UUID]payee_entity.Aliases
+}
+
+func (s *PayeeServiceImpl) ListPayees(ctx context.Context, params *ListPayeesParams) (*ListPayeesResult, error) {
+	filters := []payee_specification.PayeeSpecification{}
+
+	if exists.String(params.NameLike) {
+		filters = append(filters, payee_specification.NameLike(params.NameLike))
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	payees, err := s.payeeRepository.List(ctx, common_repository.ListArgs[payee_specification.PayeeSpecification]{
+		Filters: filters,
+		Limit:   common_specification.WithLimit(params.Pagination.Limit()),
+		Offset:  common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		s.logger.Error("payee repository list error", "detail", err.Error())
+		return nil, err
+	}
+
+	size, err := s.payeeRepository.Size(ctx, filters...)
+	if err != nil {
+		s.logger.Error("payee repository size error", "detail", err.Error())
+		return nil, err
+	}
+
+	aliases, err := s.getAliases(ctx, payees...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListPayeesResult{
+		Pagination: common_service.NewPaginationResult(params.Pagination, size),
+		Payees:     payees,
+		Aliases:    aliases,
+	}, nil
+}
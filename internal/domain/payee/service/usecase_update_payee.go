@@ -0,0 +1,68 @@
+package payee_service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+)
+
+type UpdatePayeeParams struct {
+	ID      uuid.UUID
+	Name    common_types.Maybe[string]
+	Aliases common_types.Maybe[[]string]
+}
+
+type UpdatePayeeResult struct {
+	Payee   payee_entity.Payee
+	Aliases payee_entity.Aliases
+}
+
+func (s *PayeeServiceImpl) UpdatePayee(ctx context.Context, params *UpdatePayeeParams) (*UpdatePayeeResult, error) {
+	payee, err := s.getPayee(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Name.Present {
+		payee.Name = strings.TrimSpace(params.Name.Value)
+		if err := s.checkName(ctx, payee); err != nil {
+			return nil, err
+		}
+	}
+
+	aliases := payee_entity.Aliases{}
+	if params.Aliases.Present {
+		aliases, err = newAliases(payee, params.Aliases.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	payee.UpdatedAt = time.Now()
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		if err := s.payeeRepository.Save(ctx, payee); err != nil {
+			return err
+		}
+
+		if params.Aliases.Present {
+			return s.setAliases(ctx, payee, aliases)
+		}
+
+		aliasesByID, err := s.getAliases(ctx, payee)
+		aliases = aliasesByID[payee.ID]
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &UpdatePayeeResult{
+		Payee:   payee,
+		Aliases: aliases,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package payee_specification
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+)
+
+type PayeeSpecification interface {
+	Call(payee payee_entity.Payee) bool
+}
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(payee payee_entity.Payee) bool {
+	return payee.ID == spec.ID
+}
+
+func WithID(id uuid.UUID) PayeeSpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type WithoutIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithoutIDSpecification) Call(payee payee_entity.Payee) bool {
+	return payee.ID != spec.ID
+}
+
+func WithoutID(id uuid.UUID) PayeeSpecification {
+	return WithoutIDSpecification{
+		ID: id,
+	}
+}
+
+type IDInSpecification struct {
+	IDs []uuid.UUID
+}
+
+func (spec IDInSpecification) Call(payee payee_entity.Payee) bool {
+	for _, id := range spec.IDs {
+		if payee.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IDIn(ids ...uuid.UUID) PayeeSpecification {
+	return IDInSpecification{
+		IDs: ids,
+	}
+}
+
+// NameIsSpecification matches the name regardless of case.
+type NameIsSpecification struct {
+	Name string
+}
+
+func (spec NameIsSpecification) Call(payee payee_entity.Payee) bool {
+	return strings.EqualFold(payee.Name, spec.Name)
+}
+
+func NameIs(name string) PayeeSpecification {
+	return NameIsSpecification{
+		Name: name,
+	}
+}
+
+type NameLikeSpecification struct {
+	Substring string
+}
+
+func (spec NameLikeSpecification) Call(payee payee_entity.Payee) bool {
+	return strings.Contains(strings.ToLower(payee.Name), strings.ToLower(spec.Substring))
+}
+
+func NameLike(substring string) PayeeSpecification {
+	return NameLikeSpecification{
+		Substring: substring,
+	}
+}
+
+type AliasSpecification interface {
+	Call(alias payee_entity.Alias) bool
+}
+
+type PayeeIsSpecification struct {
+	PayeeID uuid.UUID
+}
+
+func (spec PayeeIsSpecification) Call(alias payee_entity.Alias) bool {
+	return alias.PayeeID == spec.PayeeID
+}
+
+func PayeeIs(payeeID uuid.UUID) AliasSpecification {
+	return PayeeIsSpecification{
+		PayeeID: payeeID,
+	}
+}
+
+type PayeeInSpecification struct {
+	PayeeIDs []uuid.UUID
+}
+
+func (spec PayeeInSpecification) Call(alias payee_entity.Alias) bool {
+	for _, id := range spec.PayeeIDs {
+		if alias.PayeeID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func PayeeIn(payeeIDs ...uuid.UUID) AliasSpecification {
+	return PayeeInSpecification{
+		PayeeIDs: payeeIDs,
+	}
+}
+
+type PayeeIsNotSpecification struct {
+	PayeeID uuid.UUID
+}
+
+func (spec PayeeIsNotSpecification) Call(alias payee_entity.Alias) bool {
+	return alias.PayeeID != spec.PayeeID
+}
+
+func PayeeIsNot(payeeID uuid.UUID) AliasSpecification {
+	return PayeeIsNotSpecification{
+		PayeeID: payeeID,
+	}
+}
+
+// PatternIsSpecification matches the pattern regardless of case.
+type PatternIsSpecification struct {
+	Pattern string
+}
+
+func (spec PatternIsSpecification) Call(alias payee_entity.Alias) bool {
+	return strings.EqualFold(alias.Pattern, spec.Pattern)
+}
+
+func PatternIs(pattern string) AliasSpecification {
+	return PatternIsSpecification{
+		Pattern: pattern,
+	}
+}
@@ -0,0 +1,104 @@
+package rate_controller
+
+import (
+	"net/http"
+	"time"
+
+	echo "github.com/labstack/echo/v4"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	rate_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/service"
+)
+
+type RateController interface {
+	Register(*echo.Echo)
+	UpsertRates(c echo.Context) error
+	ListRates(c echo.Context) error
+}
+
+type RateControllerImpl struct {
+	logger      logger.Logger
+	rateService rate_service.RateService
+}
+
+func (ctl *RateControllerImpl) Register(e *echo.Echo) {
+	e.PUT("/v1/rates", ctl.UpsertRates)
+	e.GET("/v1/rates", ctl.ListRates)
+}
+
+func (ctl *RateControllerImpl) UpsertRates(c echo.Context) error {
+	requestJSON := &UpsertRatesRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	params := &rate_service.UpsertRatesParams{
+		Rates: []rate_service.RateParams{},
+	}
+
+	for _, r := range requestJSON.Rates {
+		date, err := time.Parse(time.DateOnly, r.Date)
+		if err != nil {
+			return common_errors.ErrBadRequest
+		}
+
+		params.Rates = append(params.Rates, rate_service.RateParams{
+			Date:  date,
+			Base:  r.Base,
+			Quote: r.Quote,
+			Rate:  r.Rate,
+		})
+	}
+
+	result, err := ctl.rateService.UpsertRates(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &UpsertRatesResponse{
+		Rates: NewRatesResponse(result.Rates),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *RateControllerImpl) ListRates(c echo.Context) error {
+	params := &rate_service.ListRatesParams{
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		String("base", &params.BaseIs).
+		String("quote", &params.QuoteIs).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.rateService.ListRates(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListRatesResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Rates:              NewRatesResponse(result.Rates),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func New(logger logger.Logger, rateService rate_service.RateService) RateController {
+	return &RateControllerImpl{
+		logger:      logger,
+		rateService: rateService,
+	}
+}
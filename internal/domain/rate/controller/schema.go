@@ -0,0 +1,64 @@
+package rate_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	rate_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/entity"
+)
+
+type RateResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Date      string    `json:"date"`
+	Base      string    `json:"base"`
+	Quote     string    `json:"quote"`
+	Rate      float64   `json:"rate"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type RatesResponse []RateResponse
+
+type ListRatesResponse struct {
+	common_schema.PaginationResponse
+	Rates RatesResponse `json:"rates"`
+}
+
+type RateRequest struct {
+	Date  string  `json:"date"`
+	Base  string  `json:"base"`
+	Quote string  `json:"quote"`
+	Rate  float64 `json:"rate"`
+}
+
+type UpsertRatesRequest struct {
+	Rates []RateRequest `json:"rates"`
+}
+
+type UpsertRatesResponse struct {
+	Rates RatesResponse `json:"rates"`
+}
+
+func NewRateResponse(rate rate_entity.Rate) RateResponse {
+	return RateResponse{
+		ID:        rate.ID,
+		Date:      rate.Date.Format(time.DateOnly),
+		Base:      rate.Base,
+		Quote:     rate.Quote,
+		Rate:      rate.Rate,
+		CreatedAt: rate.CreatedAt,
+		UpdatedAt: rate.UpdatedAt,
+	}
+}
+
+func NewRatesResponse(rates rate_entity.Rates) RatesResponse {
+	ratesResponse := RatesResponse{}
+
+	for _, r := range rates {
+		ratesResponse = append(ratesResponse, NewRateResponse(r))
+	}
+
+	return ratesResponse
+}
@@ -0,0 +1,68 @@
+package rate_entity
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+)
+
+// Rate is the amount of quote currency one unit of base currency buys on the
+// date, both in major units.
+type Rate struct {
+	ID        uuid.UUID
+	Date      time.Time
+	Base      string
+	Quote     string
+	Rate      float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Rates []Rate
+
+var NoRate = Rate{}
+var NoRates = []Rate{}
+
+// Find returns the factor converting base into quote effective on the date,
+// i.e. the latest rate published on or before it. Rates quoted the other way
+// round are inverted.
+func (r Rates) Find(base string, quote string, date time.Time) (float64, bool) {
+	var found Rate
+	factor := 0.0
+
+	for _, rate := range r {
+		if rate.Date.After(date) || (found != NoRate && !rate.Date.After(found.Date)) {
+			continue
+		}
+
+		switch {
+		case rate.Base == base && rate.Quote == quote:
+			found, factor = rate, rate.Rate
+		case rate.Base == quote && rate.Quote == base:
+			found, factor = rate, 1/rate.Rate
+		}
+	}
+
+	return factor, found != NoRate
+}
+
+// Convert converts the money into the currency using the rate effective on
+// the date. It reports false when no such rate is known.
+func (r Rates) Convert(money common_types.Money, currency string, date time.Time) (common_types.Money, bool) {
+	if money.Currency == currency {
+		return money, true
+	}
+
+	factor, ok := r.Find(money.Currency, currency, date)
+	if !ok {
+		return common_types.NoMoney, false
+	}
+
+	scale := math.Pow10(common_types.CurrencyExponent(currency) - common_types.CurrencyExponent(money.Currency))
+	amount := math.Round(float64(money.Amount) * factor * scale)
+
+	return common_types.NewMoney(int64(amount), currency), true
+}
@@ -0,0 +1,39 @@
+package rate_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrRateNotFound = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "RATE_NOT_FOUND_ERROR",
+		Template: "Exchange rate from %s to %s on %s not found. Please add the rate first.",
+	}
+
+	ErrRateDateEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RATE_DATE_EMPTY_ERROR",
+		Message: "Rate date is empty. Please pass the date the rate is effective on.",
+	}
+
+	ErrRateInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RATE_INVALID_ERROR",
+		Message: "Rate is not valid. Please pass a positive rate.",
+	}
+
+	ErrRateSameCurrency = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RATE_SAME_CURRENCY_ERROR",
+		Message: "Rate base and quote are the same currency. Please pass different currencies.",
+	}
+
+	ErrRateCSVInvalid = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "RATE_CSV_INVALID_ERROR",
+		Template: "Rate CSV is not valid on line %d: %s",
+	}
+)
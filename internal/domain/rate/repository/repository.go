@@ -0,0 +1,9 @@
+package rate_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	rate_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/entity"
+	rate_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/specification"
+)
+
+type RateRepository common_repository.Repository[rate_entity.Rate, rate_specification.RateSpecification]
@@ -0,0 +1,111 @@
+package rate_repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	rate_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/entity"
+	rate_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/specification"
+)
+
+type PostgresRateRow struct {
+	ID        uuid.UUID
+	Date      time.Time
+	Base      string
+	Quote     string
+	Rate      float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (RateRepository, error) {
+	return postgres_repository.New[rate_entity.Rate, rate_specification.RateSpecification, *PostgresRateRow](postgres_repository.Option[rate_entity.Rate, rate_specification.RateSpecification, *PostgresRateRow]{
+		Logger:    logger,
+		TableName: "rates",
+		Schema: map[string]string{
+			"id":         postgres_repository.UUID,
+			"date":       postgres_repository.Date,
+			"base":       postgres_repository.Character,
+			"quote":      postgres_repository.Character,
+			"rate":       postgres_repository.Numeric,
+			"created_at": postgres_repository.TimestampWithZone,
+			"updated_at": postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"date",
+			"base",
+			"quote",
+			"rate",
+			"created_at",
+			"updated_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...rate_specification.RateSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case rate_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				case rate_specification.DateIsSpecification:
+					where = append(where, squirrel.Eq{"date": v.Date.Format(time.DateOnly)})
+				case rate_specification.BaseIsSpecification:
+					where = append(where, squirrel.Eq{"base": v.Currency})
+				case rate_specification.QuoteIsSpecification:
+					where = append(where, squirrel.Eq{"quote": v.Currency})
+				case rate_specification.InvolvesSpecification:
+					where = append(where, squirrel.Or{squirrel.Eq{"base": v.Currency}, squirrel.Eq{"quote": v.Currency}})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresRateRow, error) {
+			row := &PostgresRateRow{}
+			if err := rows.Scan(&row.ID, &row.Date, &row.Base, &row.Quote, &row.Rate, &row.CreatedAt, &row.UpdatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresRateRow) rate_entity.Rate {
+			return rate_entity.Rate{
+				ID:        row.ID,
+				Date:      row.Date,
+				Base:      row.Base,
+				Quote:     row.Quote,
+				Rate:      row.Rate,
+				CreatedAt: row.CreatedAt,
+				UpdatedAt: row.UpdatedAt,
+			}
+		},
+		Row: func(rate rate_entity.Rate) *PostgresRateRow {
+			return &PostgresRateRow{
+				ID:        rate.ID,
+				Date:      rate.Date,
+				Base:      rate.Base,
+				Quote:     rate.Quote,
+				Rate:      rate.Rate,
+				CreatedAt: rate.CreatedAt,
+				UpdatedAt: rate.UpdatedAt,
+			}
+		},
+		Values: func(row *PostgresRateRow) []any {
+			return []any{
+				row.ID,
+				row.Date,
+				row.Base,
+				row.Quote,
+				row.Rate,
+				row.CreatedAt,
+				row.UpdatedAt,
+			}
+		},
+	})
+}
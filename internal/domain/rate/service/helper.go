@@ -0,0 +1,43 @@
+package rate_service
+
+import (
+	"time"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	rate_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/entity"
+	rate_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/errors"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+// day drops the time of day, rates are effective for a whole calendar date.
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func newRate(params RateParams) (rate_entity.Rate, error) {
+	rate := rate_entity.Rate{
+		Date:  day(params.Date),
+		Base:  common_types.NormalizeCurrency(params.Base),
+		Quote: common_types.NormalizeCurrency(params.Quote),
+		Rate:  params.Rate,
+	}
+
+	if !exists.Date(params.Date) {
+		return rate_entity.NoRate, rate_errors.ErrRateDateEmpty
+	}
+
+	if !common_types.IsCurrency(rate.Base) || !common_types.IsCurrency(rate.Quote) {
+		return rate_entity.NoRate, common_errors.ErrCurrencyInvalid
+	}
+
+	if rate.Base == rate.Quote {
+		return rate_entity.NoRate, rate_errors.ErrRateSameCurrency
+	}
+
+	if rate.Rate <= 0 {
+		return rate_entity.NoRate, rate_errors.ErrRateInvalid
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,30 @@
+package rate_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
+
+	rate_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/repository"
+)
+
+type RateService interface {
+	UpsertRates(ctx context.Context, params *UpsertRatesParams) (*UpsertRatesResult, error)
+	ListRates(ctx context.Context, params *ListRatesParams) (*ListRatesResult, error)
+	ImportRates(ctx context.Context, params *ImportRatesParams) (*ImportRatesResult, error)
+}
+
+type RateServiceImpl struct {
+	logger             logger.Logger
+	rateRepository     rate_repository.RateRepository
+	transactionManager transaction_manager.TransactionManager
+}
+
+func New(logger logger.Logger, rateRepository rate_repository.RateRepository, transactionManager transaction_manager.TransactionManager) RateService {
+	return &RateServiceImpl{
+		logger:             logger,
+		rateRepository:     rateRepository,
+		transactionManager: transactionManager,
+	}
+}
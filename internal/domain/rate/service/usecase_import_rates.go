@@ -0,0 +1,88 @@
+package rate_service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	rate_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/errors"
+)
+
+// RatesCSVColumns are the columns a rate CSV file has to carry in its header,
+// in any order.
+var RatesCSVColumns = []string{"date", "base", "quote", "rate"}
+
+type ImportRatesParams struct {
+	CSV io.Reader
+}
+
+type ImportRatesResult struct {
+	Imported uint32
+}
+
+// ImportRates upserts every rate of the CSV in one database transaction. Dates
+// are formatted as YYYY-MM-DD.
+func (s *RateServiceImpl) ImportRates(ctx context.Context, params *ImportRatesParams) (*ImportRatesResult, error) {
+	reader := csv.NewReader(params.CSV)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, rate_errors.ErrRateCSVInvalid.Format(1, "missing header")
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range RatesCSVColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, rate_errors.ErrRateCSVInvalid.Format(1, "missing column "+name)
+		}
+	}
+
+	rates := []RateParams{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, rate_errors.ErrRateCSVInvalid.Format(line, err.Error())
+		}
+
+		date, err := time.Parse(time.DateOnly, record[columns["date"]])
+		if err != nil {
+			return nil, rate_errors.ErrRateCSVInvalid.Format(line, "invalid date")
+		}
+
+		rate, err := strconv.ParseFloat(record[columns["rate"]], 64)
+		if err != nil {
+			return nil, rate_errors.ErrRateCSVInvalid.Format(line, "invalid rate")
+		}
+
+		rates = append(rates, RateParams{
+			Date:  date,
+			Base:  record[columns["base"]],
+			Quote: record[columns["quote"]],
+			Rate:  rate,
+		})
+	}
+
+	result, err := s.UpsertRates(ctx, &UpsertRatesParams{
+		Rates: rates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportRatesResult{
+		Imported: uint32(len(result.Rates)),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package rate_service
+
+import (
+	"context"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	rate_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/entity"
+	rate_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type ListRatesParams struct {
+	BaseIs     string
+	QuoteIs    string
+	Pagination common_service.PaginationParams
+}
+
+type ListRatesResult struct {
+	Pagination common_service.PaginationResult
+	Rates      rate_entity.Rates
+}
+
+func (s *RateServiceImpl) ListRates(ctx context.Context, params *ListRatesParams) (*ListRatesResult, error) {
+	filters := []rate_specification.RateSpecification{}
+
+	if exists.String(params.BaseIs) {
+		filters = append(filters, rate_specification.BaseIs(common_types.NormalizeCurrency(params.BaseIs)))
+	}
+
+	if exists.String(params.QuoteIs) {
+		filters = append(filters, rate_specification.QuoteIs(common_types.NormalizeCurrency(params.QuoteIs)))
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	rates, err := s.rateRepository.List(ctx, common_repository.ListArgs[rate_specification.RateSpecification]{
+		Filters: filters,
+		Limit:   common_specification.WithLimit(params.Pagination.Limit()),
+		Offset:  common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		s.logger.Error("rate repository list error", "detail", err.Error())
+		return nil, err
+	}
+
+	size, err := s.rateRepository.Size(ctx, filters...)
+	if err != nil {
+		s.logger.Error("rate repository size error", "detail", err.Error())
+		return nil, err
+	}
+
+	return &ListRatesResult{
+		Pagination: common_service.NewPaginationResult(params.Pagination, size),
+		Rates:      rates,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package rate_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	rate_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/entity"
+	rate_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/specification"
+)
+
+type RateParams struct {
+	Date  time.Time
+	Base  string
+	Quote string
+	Rate  float64
+}
+
+type UpsertRatesParams struct {
+	Rates []RateParams
+}
+
+type UpsertRatesResult struct {
+	Rates rate_entity.Rates
+}
+
+// UpsertRates saves the rates, replacing the ones already stored for the same
+// date and currency pair.
+func (s *RateServiceImpl) UpsertRates(ctx context.Context, params *UpsertRatesParams) (*UpsertRatesResult, error) {
+	rates := rate_entity.Rates{}
+	for _, p := range params.Rates {
+		rate, err := newRate(p)
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, rate)
+	}
+
+	now := time.Now()
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		for i, rate := range rates {
+			existing, err := s.rateRepository.Get(ctx, rate_specification.DateIs(rate.Date), rate_specification.BaseIs(rate.Base), rate_specification.QuoteIs(rate.Quote))
+			if err != nil {
+				return err
+			}
+
+			rate.ID = uuid.New()
+			rate.CreatedAt = now
+			if existing != rate_entity.NoRate {
+				rate.ID = existing.ID
+				rate.CreatedAt = existing.CreatedAt
+			}
+			rate.UpdatedAt = now
+
+			if err := s.rateRepository.Save(ctx, rate); err != nil {
+				return err
+			}
+
+			rates[i] = rate
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &UpsertRatesResult{
+		Rates: rates,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package rate_specification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	rate_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/entity"
+)
+
+type RateSpecification interface {
+	Call(rate rate_entity.Rate) bool
+}
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(rate rate_entity.Rate) bool {
+	return rate.ID == spec.ID
+}
+
+func WithID(id uuid.UUID) RateSpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type DateIsSpecification struct {
+	Date time.Time
+}
+
+func (spec DateIsSpecification) Call(rate rate_entity.Rate) bool {
+	return rate.Date.Equal(spec.Date)
+}
+
+func DateIs(date time.Time) RateSpecification {
+	return DateIsSpecification{
+		Date: date,
+	}
+}
+
+type BaseIsSpecification struct {
+	Currency string
+}
+
+func (spec BaseIsSpecification) Call(rate rate_entity.Rate) bool {
+	return rate.Base == spec.Currency
+}
+
+func BaseIs(currency string) RateSpecification {
+	return BaseIsSpecification{
+		Currency: currency,
+	}
+}
+
+type QuoteIsSpecification struct {
+	Currency string
+}
+
+func (spec QuoteIsSpecification) Call(rate rate_entity.Rate) bool {
+	return rate.Quote == spec.Currency
+}
+
+func QuoteIs(currency string) RateSpecification {
+	return QuoteIsSpecification{
+		Currency: currency,
+	}
+}
+
+// InvolvesSpecification matches rates quoting the currency on either side.
+type InvolvesSpecification struct {
+	Currency string
+}
+
+func (spec InvolvesSpecification) Call(rate rate_entity.Rate) bool {
+	return rate.Base == spec.Currency || rate.Quote == spec.Currency
+}
+
+func Involves(currency string) RateSpecification {
+	return InvolvesSpecification{
+		Currency: currency,
+	}
+}
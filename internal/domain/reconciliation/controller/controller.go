@@ -0,0 +1,240 @@
+package reconciliation_controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	reconciliation_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/service"
+)
+
+type ReconciliationController interface {
+	Register(*echo.Echo)
+	StartReconciliation(c echo.Context) error
+	GetReconciliation(c echo.Context) error
+	ListReconciliations(c echo.Context) error
+	ListReconciliationTransactions(c echo.Context) error
+	ClearTransactions(c echo.Context) error
+	UnclearTransactions(c echo.Context) error
+	FinishReconciliation(c echo.Context) error
+	ReopenReconciliation(c echo.Context) error
+	CancelReconciliation(c echo.Context) error
+}
+
+type ReconciliationControllerImpl struct {
+	logger                logger.Logger
+	reconciliationService reconciliation_service.ReconciliationService
+}
+
+func (ctl *ReconciliationControllerImpl) Register(e *echo.Echo) {
+	e.POST("/v1/reconciliations", ctl.StartReconciliation)
+	e.GET("/v1/reconciliations", ctl.ListReconciliations)
+	e.GET("/v1/reconciliations/:id", ctl.GetReconciliation)
+	e.DELETE("/v1/reconciliations/:id", ctl.CancelReconciliation)
+	e.GET("/v1/reconciliations/:id/transactions", ctl.ListReconciliationTransactions)
+	e.POST("/v1/reconciliations/:id/clear", ctl.ClearTransactions)
+	e.POST("/v1/reconciliations/:id/unclear", ctl.UnclearTransactions)
+	e.POST("/v1/reconciliations/:id/finish", ctl.FinishReconciliation)
+	e.POST("/v1/reconciliations/:id/reopen", ctl.ReopenReconciliation)
+}
+
+func (ctl *ReconciliationControllerImpl) StartReconciliation(c echo.Context) error {
+	requestJSON := &StartReconciliationRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	statementDate, err := time.Parse(time.DateOnly, requestJSON.Reconciliation.StatementDate)
+	if err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.reconciliationService.StartReconciliation(c.Request().Context(), &reconciliation_service.StartReconciliationParams{
+		AccountID:      requestJSON.Reconciliation.AccountID,
+		StatementDate:  statementDate,
+		ClosingBalance: requestJSON.Reconciliation.ClosingBalance,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, NewReconciliationSummaryResponse(result.Reconciliation, result.Summary))
+}
+
+func (ctl *ReconciliationControllerImpl) GetReconciliation(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.reconciliationService.GetReconciliation(c.Request().Context(), &reconciliation_service.GetReconciliationParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewReconciliationSummaryResponse(result.Reconciliation, result.Summary))
+}
+
+func (ctl *ReconciliationControllerImpl) ListReconciliations(c echo.Context) error {
+	params := &reconciliation_service.ListReconciliationsParams{
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		CustomFunc("account_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.AccountIs = id
+			return nil
+		}).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.reconciliationService.ListReconciliations(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListReconciliationsResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Reconciliations:    NewReconciliationsResponse(result.Reconciliations),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *ReconciliationControllerImpl) ListReconciliationTransactions(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	params := &reconciliation_service.ListReconciliationTransactionsParams{
+		ID:         id,
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.reconciliationService.ListReconciliationTransactions(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListReconciliationTransactionsResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Transactions:       NewReconciliationTransactionsResponse(result.Transactions),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *ReconciliationControllerImpl) ClearTransactions(c echo.Context) error {
+	return ctl.clearTransactions(c, true)
+}
+
+func (ctl *ReconciliationControllerImpl) UnclearTransactions(c echo.Context) error {
+	return ctl.clearTransactions(c, false)
+}
+
+func (ctl *ReconciliationControllerImpl) clearTransactions(c echo.Context, cleared bool) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &ClearTransactionsRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.reconciliationService.ClearTransactions(c.Request().Context(), &reconciliation_service.ClearTransactionsParams{
+		ID:             id,
+		TransactionIDs: requestJSON.TransactionIDs,
+		Cleared:        cleared,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewReconciliationSummaryResponse(result.Reconciliation, result.Summary))
+}
+
+func (ctl *ReconciliationControllerImpl) FinishReconciliation(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.reconciliationService.FinishReconciliation(c.Request().Context(), &reconciliation_service.FinishReconciliationParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewReconciliationSummaryResponse(result.Reconciliation, result.Summary))
+}
+
+func (ctl *ReconciliationControllerImpl) ReopenReconciliation(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.reconciliationService.ReopenReconciliation(c.Request().Context(), &reconciliation_service.ReopenReconciliationParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewReconciliationSummaryResponse(result.Reconciliation, result.Summary))
+}
+
+func (ctl *ReconciliationControllerImpl) CancelReconciliation(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	if _, err := ctl.reconciliationService.CancelReconciliation(c.Request().Context(), &reconciliation_service.CancelReconciliationParams{
+		ID: id,
+	}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func New(logger logger.Logger, reconciliationService reconciliation_service.ReconciliationService) ReconciliationController {
+	return &ReconciliationControllerImpl{
+		logger:                logger,
+		reconciliationService: reconciliationService,
+	}
+}
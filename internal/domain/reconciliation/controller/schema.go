@@ -0,0 +1,132 @@
+package reconciliation_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+)
+
+type ReconciliationResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	AccountID      uuid.UUID  `json:"account_id"`
+	StatementDate  string     `json:"statement_date"`
+	ClosingBalance int64      `json:"closing_balance"`
+	Currency       string     `json:"currency"`
+	Finished       bool       `json:"finished"`
+	FinishedAt     *time.Time `json:"finished_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type ReconciliationsResponse []ReconciliationResponse
+
+type SummaryResponse struct {
+	ClearedBalance int64  `json:"cleared_balance"`
+	Difference     int64  `json:"difference"`
+	Currency       string `json:"currency"`
+	Balanced       bool   `json:"balanced"`
+}
+
+type ReconciliationTransactionResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Description string    `json:"description"`
+	Amount      int64     `json:"amount"`
+	Currency    string    `json:"currency"`
+	Direction   string    `json:"direction"`
+	Cleared     bool      `json:"cleared"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ReconciliationRequest struct {
+	AccountID      uuid.UUID `json:"account_id"`
+	StatementDate  string    `json:"statement_date"`
+	ClosingBalance int64     `json:"closing_balance"`
+}
+
+type StartReconciliationRequest struct {
+	Reconciliation ReconciliationRequest `json:"reconciliation"`
+}
+
+type ClearTransactionsRequest struct {
+	TransactionIDs []uuid.UUID `json:"transaction_ids"`
+}
+
+// ReconciliationSummaryResponse is returned by every endpoint acting on a
+// single reconciliation.
+type ReconciliationSummaryResponse struct {
+	Reconciliation ReconciliationResponse `json:"reconciliation"`
+	Summary        SummaryResponse        `json:"summary"`
+}
+
+type ListReconciliationsResponse struct {
+	common_schema.PaginationResponse
+	Reconciliations ReconciliationsResponse `json:"reconciliations"`
+}
+
+type ListReconciliationTransactionsResponse struct {
+	common_schema.PaginationResponse
+	Transactions []ReconciliationTransactionResponse `json:"transactions"`
+}
+
+func NewReconciliationResponse(reconciliation reconciliation_entity.Reconciliation) ReconciliationResponse {
+	response := ReconciliationResponse{
+		ID:             reconciliation.ID,
+		AccountID:      reconciliation.AccountID,
+		StatementDate:  reconciliation.StatementDate.Format(time.DateOnly),
+		ClosingBalance: reconciliation.ClosingBalance.Amount,
+		Currency:       reconciliation.ClosingBalance.Currency,
+		Finished:       reconciliation.IsFinished(),
+		CreatedAt:      reconciliation.CreatedAt,
+		UpdatedAt:      reconciliation.UpdatedAt,
+	}
+
+	if reconciliation.IsFinished() {
+		response.FinishedAt = &reconciliation.FinishedAt
+	}
+
+	return response
+}
+
+func NewReconciliationsResponse(reconciliations reconciliation_entity.Reconciliations) ReconciliationsResponse {
+	reconciliationsResponse := ReconciliationsResponse{}
+
+	for _, r := range reconciliations {
+		reconciliationsResponse = append(reconciliationsResponse, NewReconciliationResponse(r))
+	}
+
+	return reconciliationsResponse
+}
+
+func NewReconciliationSummaryResponse(reconciliation reconciliation_entity.Reconciliation, summary reconciliation_entity.Summary) *ReconciliationSummaryResponse {
+	return &ReconciliationSummaryResponse{
+		Reconciliation: NewReconciliationResponse(reconciliation),
+		Summary: SummaryResponse{
+			ClearedBalance: summary.ClearedBalance.Amount,
+			Difference:     summary.Difference.Amount,
+			Currency:       summary.Difference.Currency,
+			Balanced:       summary.IsBalanced(),
+		},
+	}
+}
+
+func NewReconciliationTransactionsResponse(transactions transaction_entity.Transactions) []ReconciliationTransactionResponse {
+	transactionsResponse := []ReconciliationTransactionResponse{}
+
+	for _, t := range transactions {
+		transactionsResponse = append(transactionsResponse, ReconciliationTransactionResponse{
+			ID:          t.ID,
+			Description: t.Description,
+			Amount:      t.Amount.Amount,
+			Currency:    t.Amount.Currency,
+			Direction:   t.Direction.String(),
+			Cleared:     t.Cleared,
+			CreatedAt:   t.CreatedAt,
+		})
+	}
+
+	return transactionsResponse
+}
@@ -0,0 +1,55 @@
+package reconciliation_entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+)
+
+// Reconciliation proves the cleared transactions of an account add up to the
+// closing balance of a bank statement. Finishing it locks the transactions it
+// covers, reopening it unlocks them again.
+type Reconciliation struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+	// StatementDate is the last day covered by the statement.
+	StatementDate  time.Time
+	ClosingBalance common_types.Money
+	FinishedAt     time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type Reconciliations []Reconciliation
+
+var NoReconciliation = Reconciliation{}
+var NoReconciliations = []Reconciliation{}
+
+func (r Reconciliation) IsFinished() bool {
+	return !r.FinishedAt.IsZero()
+}
+
+// StatementEnd is the last instant of the statement date, every transaction
+// created until then is covered by the statement.
+func (r Reconciliation) StatementEnd() time.Time {
+	return r.StatementDate.AddDate(0, 0, 1).Add(-time.Microsecond)
+}
+
+// Covers reports whether the transaction may be cleared against the statement.
+func (r Reconciliation) Covers(transaction transaction_entity.Transaction) bool {
+	return transaction.AccountID == r.AccountID && !transaction.CreatedAt.After(r.StatementEnd())
+}
+
+// Summary compares the cleared transactions with the statement. The
+// reconciliation may only be finished once the difference is zero.
+type Summary struct {
+	ClearedBalance common_types.Money
+	Difference     common_types.Money
+}
+
+func (s Summary) IsBalanced() bool {
+	return s.Difference.IsZero()
+}
@@ -0,0 +1,63 @@
+package reconciliation_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrReconciliationNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "RECONCILIATION_NOT_FOUND_ERROR",
+		Message: "Reconciliation not found. Please pass valid reconciliation id.",
+	}
+
+	ErrReconciliationStatementDateEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RECONCILIATION_STATEMENT_DATE_EMPTY_ERROR",
+		Message: "Reconciliation statement date is empty. Please pass the last day covered by the statement.",
+	}
+
+	ErrReconciliationInProgress = &common_errors.Error{
+		Code:    http.StatusConflict,
+		Reason:  "RECONCILIATION_IN_PROGRESS_ERROR",
+		Message: "Account already has a reconciliation in progress. Please finish or cancel it first.",
+	}
+
+	ErrReconciliationStatementDateInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RECONCILIATION_STATEMENT_DATE_INVALID_ERROR",
+		Message: "Reconciliation statement date is not after the last reconciled statement. Please pass a later date.",
+	}
+
+	ErrReconciliationFinished = &common_errors.Error{
+		Code:    http.StatusConflict,
+		Reason:  "RECONCILIATION_FINISHED_ERROR",
+		Message: "Reconciliation is already finished. Please reopen it first.",
+	}
+
+	ErrReconciliationNotFinished = &common_errors.Error{
+		Code:    http.StatusConflict,
+		Reason:  "RECONCILIATION_NOT_FINISHED_ERROR",
+		Message: "Reconciliation is not finished yet. There is nothing to reopen.",
+	}
+
+	ErrReconciliationNotLatest = &common_errors.Error{
+		Code:    http.StatusConflict,
+		Reason:  "RECONCILIATION_NOT_LATEST_ERROR",
+		Message: "Only the latest reconciliation of the account can be reopened. Please reopen the later ones first.",
+	}
+
+	ErrReconciliationUnbalanced = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "RECONCILIATION_UNBALANCED_ERROR",
+		Template: "Cleared transactions differ from the statement balance by %s. Please clear the missing transactions.",
+	}
+
+	ErrReconciliationTransactionNotCovered = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "RECONCILIATION_TRANSACTION_NOT_COVERED_ERROR",
+		Template: "Transaction %s is not on the statement account or is dated after the statement. Please pass covered transactions only.",
+	}
+)
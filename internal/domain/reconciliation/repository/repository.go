@@ -0,0 +1,10 @@
+package reconciliation_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+	reconciliation_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/specification"
+)
+
+type ReconciliationRepository common_repository.Repository[reconciliation_entity.Reconciliation, reconciliation_specification.ReconciliationSpecification]
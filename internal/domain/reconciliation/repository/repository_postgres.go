@@ -0,0 +1,124 @@
+package reconciliation_repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+	reconciliation_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/specification"
+)
+
+type PostgresReconciliationRow struct {
+	ID             uuid.UUID
+	AccountID      uuid.UUID
+	StatementDate  time.Time
+	ClosingBalance int64
+	Currency       string
+	FinishedAt     sql.NullTime
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (ReconciliationRepository, error) {
+	return postgres_repository.New[reconciliation_entity.Reconciliation, reconciliation_specification.ReconciliationSpecification, *PostgresReconciliationRow](postgres_repository.Option[reconciliation_entity.Reconciliation, reconciliation_specification.ReconciliationSpecification, *PostgresReconciliationRow]{
+		Logger:    logger,
+		TableName: "reconciliations",
+		Schema: map[string]string{
+			"id":              postgres_repository.UUID,
+			"account_id":      postgres_repository.UUID,
+			"statement_date":  postgres_repository.Date,
+			"closing_balance": postgres_repository.BigInt,
+			"currency":        postgres_repository.Character,
+			"finished_at":     postgres_repository.TimestampWithZone,
+			"created_at":      postgres_repository.TimestampWithZone,
+			"updated_at":      postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"account_id",
+			"statement_date",
+			"closing_balance",
+			"currency",
+			"finished_at",
+			"created_at",
+			"updated_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...reconciliation_specification.ReconciliationSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case reconciliation_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				case reconciliation_specification.WithoutIDSpecification:
+					where = append(where, squirrel.NotEq{"id": v.ID})
+				case reconciliation_specification.AccountIsSpecification:
+					where = append(where, squirrel.Eq{"account_id": v.AccountID})
+				case reconciliation_specification.FinishedIsSpecification:
+					if v.Finished {
+						where = append(where, squirrel.NotEq{"finished_at": nil})
+					} else {
+						where = append(where, squirrel.Eq{"finished_at": nil})
+					}
+				case reconciliation_specification.StatementDateFromSpecification:
+					where = append(where, squirrel.GtOrEq{"statement_date": v.Date.Format(time.DateOnly)})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresReconciliationRow, error) {
+			row := &PostgresReconciliationRow{}
+			if err := rows.Scan(&row.ID, &row.AccountID, &row.StatementDate, &row.ClosingBalance, &row.Currency, &row.FinishedAt, &row.CreatedAt, &row.UpdatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresReconciliationRow) reconciliation_entity.Reconciliation {
+			return reconciliation_entity.Reconciliation{
+				ID:             row.ID,
+				AccountID:      row.AccountID,
+				StatementDate:  row.StatementDate,
+				ClosingBalance: common_types.NewMoney(row.ClosingBalance, row.Currency),
+				FinishedAt:     row.FinishedAt.Time,
+				CreatedAt:      row.CreatedAt,
+				UpdatedAt:      row.UpdatedAt,
+			}
+		},
+		Row: func(reconciliation reconciliation_entity.Reconciliation) *PostgresReconciliationRow {
+			return &PostgresReconciliationRow{
+				ID:             reconciliation.ID,
+				AccountID:      reconciliation.AccountID,
+				StatementDate:  reconciliation.StatementDate,
+				ClosingBalance: reconciliation.ClosingBalance.Amount,
+				Currency:       reconciliation.ClosingBalance.Currency,
+				FinishedAt: sql.NullTime{
+					Time:  reconciliation.FinishedAt,
+					Valid: reconciliation.IsFinished(),
+				},
+				CreatedAt: reconciliation.CreatedAt,
+				UpdatedAt: reconciliation.UpdatedAt,
+			}
+		},
+		Values: func(row *PostgresReconciliationRow) []any {
+			return []any{
+				row.ID,
+				row.AccountID,
+				row.StatementDate,
+				row.ClosingBalance,
+				row.Currency,
+				row.FinishedAt,
+				row.CreatedAt,
+				row.UpdatedAt,
+			}
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package reconciliation_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+	ledger_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/types"
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+	reconciliation_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/errors"
+	reconciliation_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/specification"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+func (s *ReconciliationServiceImpl) getReconciliation(ctx context.Context, id uuid.UUID) (reconciliation_entity.Reconciliation, error) {
+	reconciliation, err := s.reconciliationRepository.Get(ctx, reconciliation_specification.WithID(id))
+	if err != nil {
+		return reconciliation_entity.NoReconciliation, err
+	}
+
+	if reconciliation == reconciliation_entity.NoReconciliation {
+		return reconciliation_entity.NoReconciliation, reconciliation_errors.ErrReconciliationNotFound
+	}
+
+	return reconciliation, nil
+}
+
+// getOpenReconciliation returns the reconciliation when it still accepts
+// changes to the cleared transactions.
+func (s *ReconciliationServiceImpl) getOpenReconciliation(ctx context.Context, id uuid.UUID) (reconciliation_entity.Reconciliation, error) {
+	reconciliation, err := s.getReconciliation(ctx, id)
+	if err != nil {
+		return reconciliation_entity.NoReconciliation, err
+	}
+
+	if reconciliation.IsFinished() {
+		return reconciliation_entity.NoReconciliation, reconciliation_errors.ErrReconciliationFinished
+	}
+
+	return reconciliation, nil
+}
+
+// summarize totals the asset postings of every cleared transaction covered by
+// the statement, including those locked by earlier reconciliations, and
+// compares it with the closing balance.
+func (s *ReconciliationServiceImpl) summarize(ctx context.Context, reconciliation reconciliation_entity.Reconciliation) (reconciliation_entity.Summary, error) {
+	summary := reconciliation_entity.Summary{
+		ClearedBalance: common_types.NewMoney(0, reconciliation.ClosingBalance.Currency),
+		Difference:     reconciliation.ClosingBalance,
+	}
+
+	iterator, err := s.transactionRepository.Each(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: []transaction_specification.TransactionSpecification{
+			transaction_specification.AccountIs(reconciliation.AccountID),
+			transaction_specification.ClearedIs(true),
+			transaction_specification.CreatedBefore(reconciliation.StatementEnd()),
+		},
+	})
+	if err != nil {
+		return summary, err
+	}
+
+	ids := []uuid.UUID{}
+	for iterator.Next() {
+		transaction, err := iterator.Current()
+		if err != nil {
+			return summary, err
+		}
+
+		ids = append(ids, transaction.ID)
+	}
+
+	if len(ids) > 0 {
+		postings, err := s.postingRepository.List(ctx, common_repository.ListArgs[ledger_specification.PostingSpecification]{
+			Filters: []ledger_specification.PostingSpecification{
+				ledger_specification.AccountIs(ledger_types.Assets(reconciliation.AccountID)),
+				ledger_specification.TransactionIn(ids...),
+			},
+		})
+		if err != nil {
+			return summary, err
+		}
+
+		for _, posting := range postings {
+			summary.ClearedBalance, err = summary.ClearedBalance.Add(posting.Amount)
+			if err != nil {
+				return summary, err
+			}
+		}
+	}
+
+	summary.Difference, err = reconciliation.ClosingBalance.Sub(summary.ClearedBalance)
+	if err != nil {
+		return summary, err
+	}
+
+	return summary, nil
+}
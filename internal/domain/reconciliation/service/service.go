@@ -0,0 +1,51 @@
+package reconciliation_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
+
+	account_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/repository"
+	ledger_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/repository"
+	reconciliation_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/repository"
+	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
+)
+
+type ReconciliationService interface {
+	StartReconciliation(ctx context.Context, params *StartReconciliationParams) (*StartReconciliationResult, error)
+	GetReconciliation(ctx context.Context, params *GetReconciliationParams) (*GetReconciliationResult, error)
+	ListReconciliations(ctx context.Context, params *ListReconciliationsParams) (*ListReconciliationsResult, error)
+	ListReconciliationTransactions(ctx context.Context, params *ListReconciliationTransactionsParams) (*ListReconciliationTransactionsResult, error)
+	ClearTransactions(ctx context.Context, params *ClearTransactionsParams) (*ClearTransactionsResult, error)
+	FinishReconciliation(ctx context.Context, params *FinishReconciliationParams) (*FinishReconciliationResult, error)
+	ReopenReconciliation(ctx context.Context, params *ReopenReconciliationParams) (*ReopenReconciliationResult, error)
+	CancelReconciliation(ctx context.Context, params *CancelReconciliationParams) (*CancelReconciliationResult, error)
+}
+
+type ReconciliationServiceImpl struct {
+	logger                   logger.Logger
+	reconciliationRepository reconciliation_repository.ReconciliationRepository
+	transactionRepository    transaction_repository.TransactionRepository
+	accountRepository        account_repository.AccountRepository
+	postingRepository        ledger_repository.PostingRepository
+	transactionManager       transaction_manager.TransactionManager
+}
+
+func New(
+	logger logger.Logger,
+	reconciliationRepository reconciliation_repository.ReconciliationRepository,
+	transactionRepository transaction_repository.TransactionRepository,
+	accountRepository account_repository.AccountRepository,
+	postingRepository ledger_repository.PostingRepository,
+	transactionManager transaction_manager.TransactionManager,
+) ReconciliationService {
+	return &ReconciliationServiceImpl{
+		logger:                   logger,
+		reconciliationRepository: reconciliationRepository,
+		transactionRepository:    transactionRepository,
+		accountRepository:        accountRepository,
+		postingRepository:        postingRepository,
+		transactionManager:       transactionManager,
+	}
+}
@@ -0,0 +1,30 @@
+package reconciliation_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	reconciliation_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/specification"
+)
+
+type CancelReconciliationParams struct {
+	ID uuid.UUID
+}
+
+type CancelReconciliationResult struct{}
+
+// CancelReconciliation discards a reconciliation in progress. Transactions
+// keep their cleared mark for the next statement.
+func (s *ReconciliationServiceImpl) CancelReconciliation(ctx context.Context, params *CancelReconciliationParams) (*CancelReconciliationResult, error) {
+	reconciliation, err := s.getOpenReconciliation(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reconciliationRepository.Delete(ctx, reconciliation_specification.WithID(reconciliation.ID)); err != nil {
+		return nil, err
+	}
+
+	return &CancelReconciliationResult{}, nil
+}
@@ -0,0 +1,80 @@
+package reconciliation_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+	reconciliation_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/errors"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type ClearTransactionsParams struct {
+	ID             uuid.UUID
+	TransactionIDs []uuid.UUID
+	// Cleared marks the transactions as seen on the statement, or takes the
+	// mark back when false.
+	Cleared bool
+}
+
+type ClearTransactionsResult struct {
+	Reconciliation reconciliation_entity.Reconciliation
+	Summary        reconciliation_entity.Summary
+}
+
+func (s *ReconciliationServiceImpl) ClearTransactions(ctx context.Context, params *ClearTransactionsParams) (*ClearTransactionsResult, error) {
+	reconciliation, err := s.getOpenReconciliation(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		for _, id := range params.TransactionIDs {
+			transaction, err := s.transactionRepository.Get(ctx, transaction_specification.WithID(id))
+			if err != nil {
+				return err
+			}
+
+			if transaction == transaction_entity.NoTransaction {
+				return transaction_errors.ErrTransactionNotFound
+			}
+
+			if transaction.IsReconciled() {
+				return transaction_errors.ErrTransactionReconciled
+			}
+
+			if !reconciliation.Covers(transaction) {
+				return reconciliation_errors.ErrReconciliationTransactionNotCovered.Format(transaction.ID)
+			}
+
+			if transaction.Cleared == params.Cleared {
+				continue
+			}
+
+			transaction.Cleared = params.Cleared
+			transaction.UpdatedAt = time.Now()
+
+			if err := s.transactionRepository.Save(ctx, transaction); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	summary, err := s.summarize(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClearTransactionsResult{
+		Reconciliation: reconciliation,
+		Summary:        summary,
+	}, nil
+}
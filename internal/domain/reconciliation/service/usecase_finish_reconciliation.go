@@ -0,0 +1,76 @@
+package reconciliation_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+	reconciliation_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/errors"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type FinishReconciliationParams struct {
+	ID uuid.UUID
+}
+
+type FinishReconciliationResult struct {
+	Reconciliation reconciliation_entity.Reconciliation
+	Summary        reconciliation_entity.Summary
+}
+
+// FinishReconciliation locks every cleared transaction covered by the
+// statement, once they add up to the closing balance.
+func (s *ReconciliationServiceImpl) FinishReconciliation(ctx context.Context, params *FinishReconciliationParams) (*FinishReconciliationResult, error) {
+	reconciliation, err := s.getOpenReconciliation(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.summarize(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	if !summary.IsBalanced() {
+		return nil, reconciliation_errors.ErrReconciliationUnbalanced.Format(summary.Difference.String())
+	}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		transactions, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+			Filters: []transaction_specification.TransactionSpecification{
+				transaction_specification.AccountIs(reconciliation.AccountID),
+				transaction_specification.ClearedIs(true),
+				transaction_specification.ReconciledIs(false),
+				transaction_specification.CreatedBefore(reconciliation.StatementEnd()),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, transaction := range transactions {
+			transaction.ReconciliationID = reconciliation.ID
+			transaction.UpdatedAt = now
+
+			if err := s.transactionRepository.Save(ctx, transaction); err != nil {
+				return err
+			}
+		}
+
+		reconciliation.FinishedAt = now
+		reconciliation.UpdatedAt = now
+
+		return s.reconciliationRepository.Save(ctx, reconciliation)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &FinishReconciliationResult{
+		Reconciliation: reconciliation,
+		Summary:        summary,
+	}, nil
+}
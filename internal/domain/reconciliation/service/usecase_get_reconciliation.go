@@ -0,0 +1,35 @@
+package reconciliation_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+)
+
+type GetReconciliationParams struct {
+	ID uuid.UUID
+}
+
+type GetReconciliationResult struct {
+	Reconciliation reconciliation_entity.Reconciliation
+	Summary        reconciliation_entity.Summary
+}
+
+func (s *ReconciliationServiceImpl) GetReconciliation(ctx context.Context, params *GetReconciliationParams) (*GetReconciliationResult, error) {
+	reconciliation, err := s.getReconciliation(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.summarize(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetReconciliationResult{
+		Reconciliation: reconciliation,
+		Summary:        summary,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package reconciliation_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type ListReconciliationTransactionsParams struct {
+	ID         uuid.UUID
+	Pagination common_service.PaginationParams
+}
+
+type ListReconciliationTransactionsResult struct {
+	Pagination   common_service.PaginationResult
+	Transactions transaction_entity.Transactions
+}
+
+// ListReconciliationTransactions lists the transactions to tick off against
+// the statement: the unreconciled ones covered by it while in progress, the
+// ones it locked once finished.
+func (s *ReconciliationServiceImpl) ListReconciliationTransactions(ctx context.Context, params *ListReconciliationTransactionsParams) (*ListReconciliationTransactionsResult, error) {
+	reconciliation, err := s.getReconciliation(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []transaction_specification.TransactionSpecification{
+		transaction_specification.ReconciliationIs(reconciliation.ID),
+	}
+
+	if !reconciliation.IsFinished() {
+		filters = []transaction_specification.TransactionSpecification{
+			transaction_specification.AccountIs(reconciliation.AccountID),
+			transaction_specification.ReconciledIs(false),
+			transaction_specification.CreatedBefore(reconciliation.StatementEnd()),
+		}
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	transactions, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: filters,
+		Limit:   common_specification.WithLimit(params.Pagination.Limit()),
+		Offset:  common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := s.transactionRepository.Size(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListReconciliationTransactionsResult{
+		Pagination:   common_service.NewPaginationResult(params.Pagination, size),
+		Transactions: transactions,
+	}, nil
+}
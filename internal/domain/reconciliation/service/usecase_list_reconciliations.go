@@ -0,0 +1,54 @@
+package reconciliation_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+	reconciliation_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/specification"
+)
+
+type ListReconciliationsParams struct {
+	AccountIs  uuid.UUID
+	Pagination common_service.PaginationParams
+}
+
+type ListReconciliationsResult struct {
+	Pagination      common_service.PaginationResult
+	Reconciliations reconciliation_entity.Reconciliations
+}
+
+func (s *ReconciliationServiceImpl) ListReconciliations(ctx context.Context, params *ListReconciliationsParams) (*ListReconciliationsResult, error) {
+	filters := []reconciliation_specification.ReconciliationSpecification{}
+
+	if params.AccountIs != uuid.Nil {
+		filters = append(filters, reconciliation_specification.AccountIs(params.AccountIs))
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	reconciliations, err := s.reconciliationRepository.List(ctx, common_repository.ListArgs[reconciliation_specification.ReconciliationSpecification]{
+		Filters: filters,
+		Limit:   common_specification.WithLimit(params.Pagination.Limit()),
+		Offset:  common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		s.logger.Error("reconciliation repository list error", "detail", err.Error())
+		return nil, err
+	}
+
+	size, err := s.reconciliationRepository.Size(ctx, filters...)
+	if err != nil {
+		s.logger.Error("reconciliation repository size error", "detail", err.Error())
+		return nil, err
+	}
+
+	return &ListReconciliationsResult{
+		Pagination:      common_service.NewPaginationResult(params.Pagination, size),
+		Reconciliations: reconciliations,
+	}, nil
+}
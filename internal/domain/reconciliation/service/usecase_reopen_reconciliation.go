@@ -0,0 +1,88 @@
+package reconciliation_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+	reconciliation_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/errors"
+	reconciliation_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/specification"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type ReopenReconciliationParams struct {
+	ID uuid.UUID
+}
+
+type ReopenReconciliationResult struct {
+	Reconciliation reconciliation_entity.Reconciliation
+	Summary        reconciliation_entity.Summary
+}
+
+// ReopenReconciliation explicitly unlocks the transactions of the latest
+// finished reconciliation of an account, they stay cleared.
+func (s *ReconciliationServiceImpl) ReopenReconciliation(ctx context.Context, params *ReopenReconciliationParams) (*ReopenReconciliationResult, error) {
+	reconciliation, err := s.getReconciliation(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reconciliation.IsFinished() {
+		return nil, reconciliation_errors.ErrReconciliationNotFinished
+	}
+
+	later, err := s.reconciliationRepository.Exist(ctx,
+		reconciliation_specification.WithoutID(reconciliation.ID),
+		reconciliation_specification.AccountIs(reconciliation.AccountID),
+		reconciliation_specification.StatementDateFrom(reconciliation.StatementDate),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if later {
+		return nil, reconciliation_errors.ErrReconciliationNotLatest
+	}
+
+	if err := s.transactionManager.Execute(postgres_repository.Unlock(ctx), func(ctx context.Context) error {
+		transactions, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+			Filters: []transaction_specification.TransactionSpecification{
+				transaction_specification.ReconciliationIs(reconciliation.ID),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, transaction := range transactions {
+			transaction.ReconciliationID = uuid.Nil
+			transaction.UpdatedAt = now
+
+			if err := s.transactionRepository.Save(ctx, transaction); err != nil {
+				return err
+			}
+		}
+
+		reconciliation.FinishedAt = time.Time{}
+		reconciliation.UpdatedAt = now
+
+		return s.reconciliationRepository.Save(ctx, reconciliation)
+	}); err != nil {
+		return nil, err
+	}
+
+	summary, err := s.summarize(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReopenReconciliationResult{
+		Reconciliation: reconciliation,
+		Summary:        summary,
+	}, nil
+}
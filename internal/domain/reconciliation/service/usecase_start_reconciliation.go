@@ -0,0 +1,91 @@
+package reconciliation_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+	reconciliation_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/errors"
+	reconciliation_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type StartReconciliationParams struct {
+	AccountID      uuid.UUID
+	StatementDate  time.Time
+	ClosingBalance int64
+}
+
+type StartReconciliationResult struct {
+	Reconciliation reconciliation_entity.Reconciliation
+	Summary        reconciliation_entity.Summary
+}
+
+// StartReconciliation opens a reconciliation against a bank statement. An
+// account has at most one reconciliation in progress, and every statement
+// must end after the ones reconciled before.
+func (s *ReconciliationServiceImpl) StartReconciliation(ctx context.Context, params *StartReconciliationParams) (*StartReconciliationResult, error) {
+	if !exists.Date(params.StatementDate) {
+		return nil, reconciliation_errors.ErrReconciliationStatementDateEmpty
+	}
+
+	account, err := s.accountRepository.Get(ctx, account_specification.WithID(params.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	if account == account_entity.NoAccount {
+		return nil, account_errors.ErrAccountNotFound
+	}
+
+	inProgress, err := s.reconciliationRepository.Exist(ctx, reconciliation_specification.AccountIs(account.ID), reconciliation_specification.FinishedIs(false))
+	if err != nil {
+		return nil, err
+	}
+
+	if inProgress {
+		return nil, reconciliation_errors.ErrReconciliationInProgress
+	}
+
+	year, month, day := params.StatementDate.Date()
+	statementDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	overlaps, err := s.reconciliationRepository.Exist(ctx, reconciliation_specification.AccountIs(account.ID), reconciliation_specification.StatementDateFrom(statementDate))
+	if err != nil {
+		return nil, err
+	}
+
+	if overlaps {
+		return nil, reconciliation_errors.ErrReconciliationStatementDateInvalid
+	}
+
+	now := time.Now()
+	reconciliation := reconciliation_entity.Reconciliation{
+		ID:             uuid.New(),
+		AccountID:      account.ID,
+		StatementDate:  statementDate,
+		ClosingBalance: common_types.NewMoney(params.ClosingBalance, account.Currency),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := s.reconciliationRepository.Save(ctx, reconciliation); err != nil {
+		return nil, err
+	}
+
+	summary, err := s.summarize(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StartReconciliationResult{
+		Reconciliation: reconciliation,
+		Summary:        summary,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package reconciliation_specification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	reconciliation_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/entity"
+)
+
+type ReconciliationSpecification interface {
+	Call(reconciliation reconciliation_entity.Reconciliation) bool
+}
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(reconciliation reconciliation_entity.Reconciliation) bool {
+	return reconciliation.ID == spec.ID
+}
+
+func WithID(id uuid.UUID) ReconciliationSpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type WithoutIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithoutIDSpecification) Call(reconciliation reconciliation_entity.Reconciliation) bool {
+	return reconciliation.ID != spec.ID
+}
+
+func WithoutID(id uuid.UUID) ReconciliationSpecification {
+	return WithoutIDSpecification{
+		ID: id,
+	}
+}
+
+type AccountIsSpecification struct {
+	AccountID uuid.UUID
+}
+
+func (spec AccountIsSpecification) Call(reconciliation reconciliation_entity.Reconciliation) bool {
+	return reconciliation.AccountID == spec.AccountID
+}
+
+func AccountIs(accountID uuid.UUID) ReconciliationSpecification {
+	return AccountIsSpecification{
+		AccountID: accountID,
+	}
+}
+
+type FinishedIsSpecification struct {
+	Finished bool
+}
+
+func (spec FinishedIsSpecification) Call(reconciliation reconciliation_entity.Reconciliation) bool {
+	return reconciliation.IsFinished() == spec.Finished
+}
+
+func FinishedIs(finished bool) ReconciliationSpecification {
+	return FinishedIsSpecification{
+		Finished: finished,
+	}
+}
+
+// StatementDateFromSpecification matches reconciliations of statements ending
+// on or after the date.
+type StatementDateFromSpecification struct {
+	Date time.Time
+}
+
+func (spec StatementDateFromSpecification) Call(reconciliation reconciliation_entity.Reconciliation) bool {
+	return !reconciliation.StatementDate.Before(spec.Date)
+}
+
+func StatementDateFrom(date time.Time) ReconciliationSpecification {
+	return StatementDateFromSpecification{
+		Date: date,
+	}
+}
@@ -0,0 +1,269 @@
+package rule_controller
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	rule_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/service"
+	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+type RuleController interface {
+	Register(*echo.Echo)
+	CreateRule(c echo.Context) error
+	GetRule(c echo.Context) error
+	ListRules(c echo.Context) error
+	UpdateRule(c echo.Context) error
+	DeleteRule(c echo.Context) error
+	DryRunRules(c echo.Context) error
+	ReapplyRules(c echo.Context) error
+}
+
+type RuleControllerImpl struct {
+	logger      logger.Logger
+	ruleService rule_service.RuleService
+}
+
+func (ctl *RuleControllerImpl) Register(e *echo.Echo) {
+	e.POST("/v1/rules", ctl.CreateRule)
+	e.GET("/v1/rules", ctl.ListRules)
+	e.POST("/v1/rules/dry-run", ctl.DryRunRules)
+	e.POST("/v1/rules/reapply", ctl.ReapplyRules)
+	e.GET("/v1/rules/:id", ctl.GetRule)
+	e.PATCH("/v1/rules/:id", ctl.UpdateRule)
+	e.DELETE("/v1/rules/:id", ctl.DeleteRule)
+}
+
+func (ctl *RuleControllerImpl) CreateRule(c echo.Context) error {
+	requestJSON := &CreateRuleRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.ruleService.CreateRule(c.Request().Context(), &rule_service.CreateRuleParams{
+		Name:      requestJSON.Rule.Name,
+		Priority:  requestJSON.Rule.Priority,
+		Condition: requestJSON.Rule.Condition.Condition(),
+		Action:    requestJSON.Rule.Action.Action(),
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &CreateRuleResponse{
+		Rule: NewRuleResponse(result.Rule),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (ctl *RuleControllerImpl) GetRule(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.ruleService.GetRule(c.Request().Context(), &rule_service.GetRuleParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &GetRuleResponse{
+		Rule: NewRuleResponse(result.Rule),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *RuleControllerImpl) ListRules(c echo.Context) error {
+	params := &rule_service.ListRulesParams{
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.ruleService.ListRules(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListRulesResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Rules:              NewRulesResponse(result.Rules),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *RuleControllerImpl) UpdateRule(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &UpdateRuleRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	params := &rule_service.UpdateRuleParams{
+		ID: id,
+	}
+
+	if requestJSON.Rule.Name != nil {
+		params.Name = common_types.Maybe[string]{Present: true, Value: *requestJSON.Rule.Name}
+	}
+
+	if requestJSON.Rule.Priority != nil {
+		params.Priority = common_types.Maybe[int32]{Present: true, Value: *requestJSON.Rule.Priority}
+	}
+
+	if requestJSON.Rule.Condition != nil {
+		params.Condition = common_types.Maybe[rule_entity.Condition]{Present: true, Value: requestJSON.Rule.Condition.Condition()}
+	}
+
+	if requestJSON.Rule.Action != nil {
+		params.Action = common_types.Maybe[rule_entity.Action]{Present: true, Value: requestJSON.Rule.Action.Action()}
+	}
+
+	result, err := ctl.ruleService.UpdateRule(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &UpdateRuleResponse{
+		Rule: NewRuleResponse(result.Rule),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *RuleControllerImpl) DeleteRule(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	if _, err := ctl.ruleService.DeleteRule(c.Request().Context(), &rule_service.DeleteRuleParams{
+		ID: id,
+	}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (ctl *RuleControllerImpl) DryRunRules(c echo.Context) error {
+	requestJSON := &DryRunRulesRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	params := &rule_service.DryRunRulesParams{}
+
+	if err := ctl.bindFilter(c, &params.FilterTransactionsParams); err != nil {
+		return err
+	}
+
+	if requestJSON.Rule != nil {
+		params.Rule = &rule_entity.Rule{
+			Name:      requestJSON.Rule.Name,
+			Priority:  requestJSON.Rule.Priority,
+			Condition: requestJSON.Rule.Condition.Condition(),
+			Action:    requestJSON.Rule.Action.Action(),
+		}
+	}
+
+	result, err := ctl.ruleService.DryRunRules(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := NewDryRunRulesResponse(result.Matches)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *RuleControllerImpl) ReapplyRules(c echo.Context) error {
+	params := &rule_service.ReapplyRulesParams{}
+
+	if err := ctl.bindFilter(c, &params.FilterTransactionsParams); err != nil {
+		return err
+	}
+
+	result, err := ctl.ruleService.ReapplyRules(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ReapplyRulesResponse{
+		Updated: result.Updated,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// bindFilter reads the transaction filters accepted by the transaction list
+// endpoint, so rules can be run against a subset of transactions.
+func (ctl *RuleControllerImpl) bindFilter(c echo.Context, params *transaction_service.FilterTransactionsParams) error {
+	params.DirectionIs = transaction_types.NoDirection
+
+	if err := echo.QueryParamsBinder(c).
+		String("description_like", &params.DescriptionLike).
+		CustomFunc("direction_is", func(values []string) []error {
+			params.DirectionIs = transaction_types.GetDirection(values[0])
+			return nil
+		}).
+		CustomFunc("account_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.AccountIs = id
+			return nil
+		}).
+		CustomFunc("category_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.CategoryIs = id
+			return nil
+		}).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func New(logger logger.Logger, ruleService rule_service.RuleService) RuleController {
+	return &RuleControllerImpl{
+		logger:      logger,
+		ruleService: ruleService,
+	}
+}
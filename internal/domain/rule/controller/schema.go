@@ -0,0 +1,191 @@
+package rule_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	rule_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/service"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	transaction_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/controller"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+)
+
+type ConditionSchema struct {
+	DescriptionPattern  string     `json:"description_pattern"`
+	DescriptionContains string     `json:"description_contains"`
+	AmountFrom          int64      `json:"amount_from"`
+	AmountTo            int64      `json:"amount_to"`
+	CreatedFrom         *time.Time `json:"created_from"`
+	CreatedTo           *time.Time `json:"created_to"`
+}
+
+type ActionSchema struct {
+	RewriteDescription string `json:"rewrite_description"`
+	Label              string `json:"label"`
+	Ignore             bool   `json:"ignore"`
+}
+
+type RuleResponse struct {
+	ID        uuid.UUID       `json:"id"`
+	Name      string          `json:"name"`
+	Priority  int32           `json:"priority"`
+	Condition ConditionSchema `json:"condition"`
+	Action    ActionSchema    `json:"action"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+type RulesResponse []RuleResponse
+
+type ListRulesResponse struct {
+	common_schema.PaginationResponse
+	Rules RulesResponse `json:"rules"`
+}
+
+type RuleRequest struct {
+	Name      string          `json:"name"`
+	Priority  int32           `json:"priority"`
+	Condition ConditionSchema `json:"condition"`
+	Action    ActionSchema    `json:"action"`
+}
+
+type CreateRuleRequest struct {
+	Rule RuleRequest `json:"rule"`
+}
+
+type CreateRuleResponse struct {
+	Rule RuleResponse `json:"rule"`
+}
+
+type GetRuleResponse struct {
+	Rule RuleResponse `json:"rule"`
+}
+
+type UpdateRuleFieldsRequest struct {
+	Name      *string          `json:"name"`
+	Priority  *int32           `json:"priority"`
+	Condition *ConditionSchema `json:"condition"`
+	Action    *ActionSchema    `json:"action"`
+}
+
+type UpdateRuleRequest struct {
+	Rule UpdateRuleFieldsRequest `json:"rule"`
+}
+
+type UpdateRuleResponse struct {
+	Rule RuleResponse `json:"rule"`
+}
+
+type DryRunRulesRequest struct {
+	Rule *RuleRequest `json:"rule"`
+}
+
+type RuleMatchResponse struct {
+	Before transaction_controller.TransactionResponse `json:"before"`
+	After  transaction_controller.TransactionResponse `json:"after"`
+}
+
+type DryRunRulesResponse struct {
+	Matches []RuleMatchResponse `json:"matches"`
+}
+
+type ReapplyRulesResponse struct {
+	Updated uint32 `json:"updated"`
+}
+
+func (schema ConditionSchema) Condition() rule_entity.Condition {
+	condition := rule_entity.Condition{
+		DescriptionPattern:  schema.DescriptionPattern,
+		DescriptionContains: schema.DescriptionContains,
+		AmountFrom:          schema.AmountFrom,
+		AmountTo:            schema.AmountTo,
+	}
+
+	if schema.CreatedFrom != nil {
+		condition.CreatedFrom = *schema.CreatedFrom
+	}
+
+	if schema.CreatedTo != nil {
+		condition.CreatedTo = *schema.CreatedTo
+	}
+
+	return condition
+}
+
+func (schema ActionSchema) Action() rule_entity.Action {
+	return rule_entity.Action{
+		RewriteDescription: schema.RewriteDescription,
+		Label:              schema.Label,
+		Ignore:             schema.Ignore,
+	}
+}
+
+func NewConditionSchema(condition rule_entity.Condition) ConditionSchema {
+	schema := ConditionSchema{
+		DescriptionPattern:  condition.DescriptionPattern,
+		DescriptionContains: condition.DescriptionContains,
+		AmountFrom:          condition.AmountFrom,
+		AmountTo:            condition.AmountTo,
+	}
+
+	if exists.Date(condition.CreatedFrom) {
+		schema.CreatedFrom = &condition.CreatedFrom
+	}
+
+	if exists.Date(condition.CreatedTo) {
+		schema.CreatedTo = &condition.CreatedTo
+	}
+
+	return schema
+}
+
+func NewActionSchema(action rule_entity.Action) ActionSchema {
+	return ActionSchema{
+		RewriteDescription: action.RewriteDescription,
+		Label:              action.Label,
+		Ignore:             action.Ignore,
+	}
+}
+
+func NewRuleResponse(rule rule_entity.Rule) RuleResponse {
+	return RuleResponse{
+		ID:        rule.ID,
+		Name:      rule.Name,
+		Priority:  rule.Priority,
+		Condition: NewConditionSchema(rule.Condition),
+		Action:    NewActionSchema(rule.Action),
+		CreatedAt: rule.CreatedAt,
+		UpdatedAt: rule.UpdatedAt,
+	}
+}
+
+func NewRulesResponse(rules rule_entity.Rules) RulesResponse {
+	rulesResponse := RulesResponse{}
+
+	for _, r := range rules {
+		rulesResponse = append(rulesResponse, NewRuleResponse(r))
+	}
+
+	return rulesResponse
+}
+
+func NewDryRunRulesResponse(matches []rule_service.RuleMatch) DryRunRulesResponse {
+	response := DryRunRulesResponse{
+		Matches: []RuleMatchResponse{},
+	}
+
+	for _, m := range matches {
+		response.Matches = append(response.Matches, RuleMatchResponse{
+			Before: transaction_controller.NewTransactionResponse(m.Before, payee_entity.NoPayee, transaction_entity.NoSplits, tag_entity.NoTags),
+			After:  transaction_controller.NewTransactionResponse(m.After, payee_entity.NoPayee, transaction_entity.NoSplits, tag_entity.NoTags),
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,145 @@
+package rule_entity
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type Rule struct {
+	ID        uuid.UUID
+	Name      string
+	Priority  int32
+	Condition Condition
+	Action    Action
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Condition holds the criteria a transaction has to meet for the rule to
+// apply. Zero-valued criteria are not checked.
+type Condition struct {
+	DescriptionPattern  string
+	DescriptionContains string
+	AmountFrom          int64
+	AmountTo            int64
+	CreatedFrom         time.Time
+	CreatedTo           time.Time
+}
+
+type Action struct {
+	RewriteDescription string
+	Label              string
+	Ignore             bool
+}
+
+type Rules []Rule
+
+var NoRule = Rule{}
+var NoRules = []Rule{}
+
+func (c Condition) IsEmpty() bool {
+	return c == Condition{}
+}
+
+func (a Action) IsEmpty() bool {
+	return a == Action{}
+}
+
+// Match reports whether the transaction meets every criteria of the condition.
+// Amounts are compared by magnitude, so outgoing transfer legs match as well.
+func (c Condition) Match(transaction transaction_entity.Transaction) bool {
+	if exists.String(c.DescriptionPattern) {
+		pattern, err := regexp.Compile(c.DescriptionPattern)
+		if err != nil || !pattern.MatchString(transaction.Description) {
+			return false
+		}
+	}
+
+	if exists.String(c.DescriptionContains) && !strings.Contains(strings.ToLower(transaction.Description), strings.ToLower(c.DescriptionContains)) {
+		return false
+	}
+
+	amount := transaction.Amount.Abs().Amount
+
+	if c.AmountFrom != 0 && amount < c.AmountFrom {
+		return false
+	}
+
+	if c.AmountTo != 0 && amount > c.AmountTo {
+		return false
+	}
+
+	if exists.Date(c.CreatedFrom) && transaction.CreatedAt.Before(c.CreatedFrom) {
+		return false
+	}
+
+	if exists.Date(c.CreatedTo) && transaction.CreatedAt.After(c.CreatedTo) {
+		return false
+	}
+
+	return true
+}
+
+func (r Rule) Match(transaction transaction_entity.Transaction) bool {
+	return r.Condition.Match(transaction)
+}
+
+// Sorted returns the rules in evaluation order: highest priority first, ties
+// broken by creation time.
+func (rules Rules) Sorted() Rules {
+	sorted := make(Rules, len(rules))
+	copy(sorted, rules)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	return sorted
+}
+
+// Apply runs the rules in priority order against the transaction. Conditions
+// are checked against the transaction as it was passed in, the first matching
+// rule that rewrites the description or attaches a label wins, and any
+// matching rule can mark the transaction as ignored. The rule label and
+// ignored flag are worked out from scratch, so they are cleared once no rule
+// sets them anymore, while those set by the user are left alone.
+func (rules Rules) Apply(transaction transaction_entity.Transaction) transaction_entity.Transaction {
+	result := transaction
+	result.RuleLabel = ""
+	result.RuleIgnored = false
+	rewritten := false
+	labeled := false
+
+	for _, rule := range rules.Sorted() {
+		if !rule.Match(transaction) {
+			continue
+		}
+
+		if !rewritten && exists.String(rule.Action.RewriteDescription) {
+			result.Description = rule.Action.RewriteDescription
+			rewritten = true
+		}
+
+		if !labeled && exists.String(rule.Action.Label) {
+			result.RuleLabel = rule.Action.Label
+			labeled = true
+		}
+
+		if rule.Action.Ignore {
+			result.RuleIgnored = true
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,205 @@
+package rule_entity
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+func TestConditionMatch(t *testing.T) {
+	transaction := transaction_entity.Transaction{
+		Description: "GRAB*FOOD 12345 Jakarta",
+		Amount:      common_types.NewMoney(50000, "IDR"),
+		Direction:   transaction_types.Expense,
+		CreatedAt:   time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name        string
+		condition   Condition
+		transaction transaction_entity.Transaction
+		want        bool
+	}{
+		{name: "empty condition", condition: Condition{}, transaction: transaction, want: true},
+		{name: "pattern", condition: Condition{DescriptionPattern: `^GRAB\*`}, transaction: transaction, want: true},
+		{name: "pattern mismatch", condition: Condition{DescriptionPattern: `^GOJEK`}, transaction: transaction, want: false},
+		{name: "invalid pattern", condition: Condition{DescriptionPattern: `(`}, transaction: transaction, want: false},
+		{name: "contains ignores case", condition: Condition{DescriptionContains: "grab*food"}, transaction: transaction, want: true},
+		{name: "contains mismatch", condition: Condition{DescriptionContains: "gojek"}, transaction: transaction, want: false},
+		{name: "amount within bounds", condition: Condition{AmountFrom: 50000, AmountTo: 50000}, transaction: transaction, want: true},
+		{name: "amount below the lower bound", condition: Condition{AmountFrom: 50001}, transaction: transaction, want: false},
+		{name: "amount above the upper bound", condition: Condition{AmountTo: 49999}, transaction: transaction, want: false},
+		{
+			name:      "amount compared by magnitude",
+			condition: Condition{AmountFrom: 40000, AmountTo: 60000},
+			transaction: transaction_entity.Transaction{
+				Amount:    common_types.NewMoney(-50000, "IDR"),
+				Direction: transaction_types.Transfer,
+			},
+			want: true,
+		},
+		{name: "created within the range", condition: Condition{CreatedFrom: transaction.CreatedAt, CreatedTo: transaction.CreatedAt}, transaction: transaction, want: true},
+		{name: "created before the range", condition: Condition{CreatedFrom: transaction.CreatedAt.Add(time.Second)}, transaction: transaction, want: false},
+		{name: "created after the range", condition: Condition{CreatedTo: transaction.CreatedAt.Add(-time.Second)}, transaction: transaction, want: false},
+		{name: "every criteria met", condition: Condition{DescriptionContains: "food", AmountFrom: 1000, CreatedTo: transaction.CreatedAt}, transaction: transaction, want: true},
+		{name: "one criteria failed", condition: Condition{DescriptionContains: "food", AmountFrom: 100000}, transaction: transaction, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Match(tt.transaction); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRulesSorted(t *testing.T) {
+	earlier := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+
+	rules := Rules{
+		{Name: "low", Priority: 1, CreatedAt: earlier},
+		{Name: "high later", Priority: 10, CreatedAt: later},
+		{Name: "high earlier", Priority: 10, CreatedAt: earlier},
+	}
+
+	got := []string{}
+	for _, rule := range rules.Sorted() {
+		got = append(got, rule.Name)
+	}
+
+	want := []string{"high earlier", "high later", "low"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sorted() = %v, want %v", got, want)
+	}
+
+	if rules[0].Name != "low" {
+		t.Errorf("Sorted() reordered the rules it was called on")
+	}
+}
+
+func TestRulesApply(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	transaction := transaction_entity.Transaction{
+		Description: "GRAB*FOOD 12345",
+		Amount:      common_types.NewMoney(50000, "IDR"),
+		Direction:   transaction_types.Expense,
+	}
+
+	tests := []struct {
+		name  string
+		rules Rules
+		want  transaction_entity.Transaction
+	}{
+		{
+			name:  "no rules",
+			rules: Rules{},
+			want:  transaction,
+		},
+		{
+			name: "rule not matching",
+			rules: Rules{
+				{Condition: Condition{DescriptionContains: "gojek"}, Action: Action{Label: "Transport", Ignore: true}},
+			},
+			want: transaction,
+		},
+		{
+			name: "rewrites and labels",
+			rules: Rules{
+				{Condition: Condition{DescriptionContains: "grab"}, Action: Action{RewriteDescription: "GrabFood", Label: "Food"}},
+			},
+			want: transaction_entity.Transaction{Description: "GrabFood", RuleLabel: "Food", Amount: transaction.Amount, Direction: transaction.Direction},
+		},
+		{
+			name: "highest priority wins",
+			rules: Rules{
+				{Priority: 1, Condition: Condition{DescriptionContains: "grab"}, Action: Action{RewriteDescription: "Grab", Label: "Transport"}},
+				{Priority: 5, Condition: Condition{DescriptionContains: "food"}, Action: Action{RewriteDescription: "GrabFood", Label: "Food"}},
+			},
+			want: transaction_entity.Transaction{Description: "GrabFood", RuleLabel: "Food", Amount: transaction.Amount, Direction: transaction.Direction},
+		},
+		{
+			name: "earlier rule wins a priority tie",
+			rules: Rules{
+				{CreatedAt: createdAt.Add(time.Hour), Condition: Condition{DescriptionContains: "grab"}, Action: Action{Label: "Transport"}},
+				{CreatedAt: createdAt, Condition: Condition{DescriptionContains: "grab"}, Action: Action{Label: "Food"}},
+			},
+			want: transaction_entity.Transaction{Description: transaction.Description, RuleLabel: "Food", Amount: transaction.Amount, Direction: transaction.Direction},
+		},
+		{
+			name: "actions of different rules combine",
+			rules: Rules{
+				{Priority: 5, Condition: Condition{DescriptionContains: "grab"}, Action: Action{RewriteDescription: "GrabFood"}},
+				{Priority: 1, Condition: Condition{DescriptionContains: "grab"}, Action: Action{Label: "Food"}},
+			},
+			want: transaction_entity.Transaction{Description: "GrabFood", RuleLabel: "Food", Amount: transaction.Amount, Direction: transaction.Direction},
+		},
+		{
+			name: "conditions see the original description",
+			rules: Rules{
+				{Priority: 5, Condition: Condition{DescriptionContains: "grab"}, Action: Action{RewriteDescription: "Lunch"}},
+				{Priority: 1, Condition: Condition{DescriptionContains: "lunch"}, Action: Action{Label: "Food"}},
+			},
+			want: transaction_entity.Transaction{Description: "Lunch", Amount: transaction.Amount, Direction: transaction.Direction},
+		},
+		{
+			name: "any matching rule ignores",
+			rules: Rules{
+				{Priority: 5, Condition: Condition{DescriptionContains: "grab"}, Action: Action{Label: "Food"}},
+				{Priority: 1, Condition: Condition{AmountFrom: 10000}, Action: Action{Ignore: true}},
+			},
+			want: transaction_entity.Transaction{Description: transaction.Description, RuleLabel: "Food", RuleIgnored: true, Amount: transaction.Amount, Direction: transaction.Direction},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.Apply(transaction); got != tt.want {
+				t.Errorf("Apply() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRulesApplyFromScratch(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       Rules
+		transaction transaction_entity.Transaction
+		want        transaction_entity.Transaction
+	}{
+		{
+			name:        "removed rule un-ignores",
+			rules:       Rules{},
+			transaction: transaction_entity.Transaction{Description: "GRAB*FOOD 12345", RuleLabel: "Food", RuleIgnored: true},
+			want:        transaction_entity.Transaction{Description: "GRAB*FOOD 12345"},
+		},
+		{
+			name: "changed rule relabels",
+			rules: Rules{
+				{Condition: Condition{DescriptionContains: "grab"}, Action: Action{Label: "Transport"}},
+			},
+			transaction: transaction_entity.Transaction{Description: "GRAB*FOOD 12345", RuleLabel: "Food", RuleIgnored: true},
+			want:        transaction_entity.Transaction{Description: "GRAB*FOOD 12345", RuleLabel: "Transport"},
+		},
+		{
+			name:        "user label and ignored are kept",
+			rules:       Rules{},
+			transaction: transaction_entity.Transaction{Description: "GRAB*FOOD 12345", Label: "Lunch", Ignored: true, RuleLabel: "Food"},
+			want:        transaction_entity.Transaction{Description: "GRAB*FOOD 12345", Label: "Lunch", Ignored: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.Apply(tt.transaction); got != tt.want {
+				t.Errorf("Apply() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
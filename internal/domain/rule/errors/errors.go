@@ -0,0 +1,57 @@
+package rule_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrRuleNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "RULE_NOT_FOUND_ERROR",
+		Message: "Rule not found. Please pass valid rule id.",
+	}
+
+	ErrRuleAlreadyExist = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RULE_ALREADY_EXIST_ERROR",
+		Message: "Rule already exists. Please use different name.",
+	}
+
+	ErrRuleNameEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RULE_NAME_EMPTY_ERROR",
+		Message: "Rule name is empty. Please pass non-empty name.",
+	}
+
+	ErrRuleConditionEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RULE_CONDITION_EMPTY_ERROR",
+		Message: "Rule has no condition. Please pass at least one condition.",
+	}
+
+	ErrRuleActionEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RULE_ACTION_EMPTY_ERROR",
+		Message: "Rule has no action. Please pass at least one action.",
+	}
+
+	ErrRuleDescriptionPatternInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RULE_DESCRIPTION_PATTERN_INVALID_ERROR",
+		Message: "Rule description pattern is not a valid regular expression.",
+	}
+
+	ErrRuleAmountRangeInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RULE_AMOUNT_RANGE_INVALID_ERROR",
+		Message: "Rule amount range is not valid. Amounts must be positive and the lower bound must not exceed the upper bound.",
+	}
+
+	ErrRuleCreatedRangeInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "RULE_CREATED_RANGE_INVALID_ERROR",
+		Message: "Rule created at window is not valid. The start must not be after the end.",
+	}
+)
@@ -0,0 +1,9 @@
+package rule_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	rule_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/specification"
+)
+
+type RuleRepository common_repository.Repository[rule_entity.Rule, rule_specification.RuleSpecification]
@@ -0,0 +1,213 @@
+package rule_repository
+
+import (
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	rule_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/specification"
+)
+
+type PostgresRuleRow struct {
+	ID                  uuid.NullUUID
+	Name                sql.NullString
+	Priority            sql.NullInt32
+	DescriptionPattern  sql.NullString
+	DescriptionContains sql.NullString
+	AmountFrom          sql.NullInt64
+	AmountTo            sql.NullInt64
+	CreatedFrom         sql.NullTime
+	CreatedTo           sql.NullTime
+	RewriteDescription  sql.NullString
+	Label               sql.NullString
+	Ignore              sql.NullBool
+	CreatedAt           sql.NullTime
+	UpdatedAt           sql.NullTime
+}
+
+var NoPostgresRuleRow = PostgresRuleRow{}
+
+func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (RuleRepository, error) {
+	return postgres_repository.New[rule_entity.Rule, rule_specification.RuleSpecification, PostgresRuleRow](postgres_repository.Option[rule_entity.Rule, rule_specification.RuleSpecification, PostgresRuleRow]{
+		Logger:    logger,
+		TableName: "rules",
+		Schema: map[string]string{
+			"id":                   postgres_repository.UUID,
+			"name":                 postgres_repository.CharacterVarying,
+			"priority":             postgres_repository.Integer,
+			"description_pattern":  postgres_repository.CharacterVarying,
+			"description_contains": postgres_repository.CharacterVarying,
+			"amount_from":          postgres_repository.BigInt,
+			"amount_to":            postgres_repository.BigInt,
+			"created_from":         postgres_repository.TimestampWithZone,
+			"created_to":           postgres_repository.TimestampWithZone,
+			"rewrite_description":  postgres_repository.CharacterVarying,
+			"label":                postgres_repository.CharacterVarying,
+			"ignore":               postgres_repository.Boolean,
+			"created_at":           postgres_repository.TimestampWithZone,
+			"updated_at":           postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"name",
+			"priority",
+			"description_pattern",
+			"description_contains",
+			"amount_from",
+			"amount_to",
+			"created_from",
+			"created_to",
+			"rewrite_description",
+			"label",
+			"ignore",
+			"created_at",
+			"updated_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...rule_specification.RuleSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case rule_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				case rule_specification.WithoutIDSpecification:
+					where = append(where, squirrel.NotEq{"id": v.ID})
+				case rule_specification.NameIsSpecification:
+					where = append(where, squirrel.Eq{"name": v.Name})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (PostgresRuleRow, error) {
+			row := PostgresRuleRow{}
+			if err := rows.Scan(
+				&row.ID,
+				&row.Name,
+				&row.Priority,
+				&row.DescriptionPattern,
+				&row.DescriptionContains,
+				&row.AmountFrom,
+				&row.AmountTo,
+				&row.CreatedFrom,
+				&row.CreatedTo,
+				&row.RewriteDescription,
+				&row.Label,
+				&row.Ignore,
+				&row.CreatedAt,
+				&row.UpdatedAt,
+			); err != nil {
+				return NoPostgresRuleRow, err
+			}
+
+			return row, nil
+		},
+		Entity: func(row PostgresRuleRow) rule_entity.Rule {
+			return rule_entity.Rule{
+				ID:       row.ID.UUID,
+				Name:     row.Name.String,
+				Priority: row.Priority.Int32,
+				Condition: rule_entity.Condition{
+					DescriptionPattern:  row.DescriptionPattern.String,
+					DescriptionContains: row.DescriptionContains.String,
+					AmountFrom:          row.AmountFrom.Int64,
+					AmountTo:            row.AmountTo.Int64,
+					CreatedFrom:         row.CreatedFrom.Time,
+					CreatedTo:           row.CreatedTo.Time,
+				},
+				Action: rule_entity.Action{
+					RewriteDescription: row.RewriteDescription.String,
+					Label:              row.Label.String,
+					Ignore:             row.Ignore.Bool,
+				},
+				CreatedAt: row.CreatedAt.Time,
+				UpdatedAt: row.UpdatedAt.Time,
+			}
+		},
+		Row: func(rule rule_entity.Rule) PostgresRuleRow {
+			return PostgresRuleRow{
+				ID: uuid.NullUUID{
+					UUID:  rule.ID,
+					Valid: true,
+				},
+				Name: sql.NullString{
+					String: rule.Name,
+					Valid:  exists.String(rule.Name),
+				},
+				Priority: sql.NullInt32{
+					Int32: rule.Priority,
+					Valid: true,
+				},
+				DescriptionPattern: sql.NullString{
+					String: rule.Condition.DescriptionPattern,
+					Valid:  exists.String(rule.Condition.DescriptionPattern),
+				},
+				DescriptionContains: sql.NullString{
+					String: rule.Condition.DescriptionContains,
+					Valid:  exists.String(rule.Condition.DescriptionContains),
+				},
+				AmountFrom: sql.NullInt64{
+					Int64: rule.Condition.AmountFrom,
+					Valid: rule.Condition.AmountFrom != 0,
+				},
+				AmountTo: sql.NullInt64{
+					Int64: rule.Condition.AmountTo,
+					Valid: rule.Condition.AmountTo != 0,
+				},
+				CreatedFrom: sql.NullTime{
+					Time:  rule.Condition.CreatedFrom,
+					Valid: exists.Date(rule.Condition.CreatedFrom),
+				},
+				CreatedTo: sql.NullTime{
+					Time:  rule.Condition.CreatedTo,
+					Valid: exists.Date(rule.Condition.CreatedTo),
+				},
+				RewriteDescription: sql.NullString{
+					String: rule.Action.RewriteDescription,
+					Valid:  exists.String(rule.Action.RewriteDescription),
+				},
+				Label: sql.NullString{
+					String: rule.Action.Label,
+					Valid:  exists.String(rule.Action.Label),
+				},
+				Ignore: sql.NullBool{
+					Bool:  rule.Action.Ignore,
+					Valid: true,
+				},
+				CreatedAt: sql.NullTime{
+					Time:  rule.CreatedAt,
+					Valid: exists.Date(rule.CreatedAt),
+				},
+				UpdatedAt: sql.NullTime{
+					Time:  rule.UpdatedAt,
+					Valid: exists.Date(rule.UpdatedAt),
+				},
+			}
+		},
+		Values: func(row PostgresRuleRow) []any {
+			return []any{
+				row.ID,
+				row.Name,
+				row.Priority,
+				row.DescriptionPattern,
+				row.DescriptionContains,
+				row.AmountFrom,
+				row.AmountTo,
+				row.CreatedFrom,
+				row.CreatedTo,
+				row.RewriteDescription,
+				row.Label,
+				row.Ignore,
+				row.CreatedAt,
+				row.UpdatedAt,
+			}
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package rule_service
+
+import (
+	"context"
+	"regexp"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	rule_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/errors"
+	rule_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/specification"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+// validateRule checks the condition and action of the rule.
+func (s *RuleServiceImpl) validateRule(rule rule_entity.Rule) error {
+	if rule.Condition.IsEmpty() {
+		return rule_errors.ErrRuleConditionEmpty
+	}
+
+	if rule.Action.IsEmpty() {
+		return rule_errors.ErrRuleActionEmpty
+	}
+
+	if exists.String(rule.Condition.DescriptionPattern) {
+		if _, err := regexp.Compile(rule.Condition.DescriptionPattern); err != nil {
+			return rule_errors.ErrRuleDescriptionPatternInvalid
+		}
+	}
+
+	if rule.Condition.AmountFrom < 0 || rule.Condition.AmountTo < 0 {
+		return rule_errors.ErrRuleAmountRangeInvalid
+	}
+
+	if rule.Condition.AmountTo != 0 && rule.Condition.AmountFrom > rule.Condition.AmountTo {
+		return rule_errors.ErrRuleAmountRangeInvalid
+	}
+
+	if exists.Date(rule.Condition.CreatedFrom) && exists.Date(rule.Condition.CreatedTo) && rule.Condition.CreatedFrom.After(rule.Condition.CreatedTo) {
+		return rule_errors.ErrRuleCreatedRangeInvalid
+	}
+
+	return nil
+}
+
+func (s *RuleServiceImpl) checkName(ctx context.Context, rule rule_entity.Rule) error {
+	if !exists.String(rule.Name) {
+		return rule_errors.ErrRuleNameEmpty
+	}
+
+	exist, err := s.ruleRepository.Exist(ctx, rule_specification.NameIs(rule.Name), rule_specification.WithoutID(rule.ID))
+	if err != nil {
+		return err
+	}
+
+	if exist {
+		return rule_errors.ErrRuleAlreadyExist
+	}
+
+	return nil
+}
+
+func (s *RuleServiceImpl) listRules(ctx context.Context) (rule_entity.Rules, error) {
+	rules, err := s.ruleRepository.List(ctx, common_repository.ListArgs[rule_specification.RuleSpecification]{})
+	if err != nil {
+		return nil, err
+	}
+
+	return rule_entity.Rules(rules), nil
+}
+
+// eachChange walks the transactions matching the filters and calls fn for
+// every one the rules would change.
+func (s *RuleServiceImpl) eachChange(ctx context.Context, rules rule_entity.Rules, filters []transaction_specification.TransactionSpecification, fn func(before transaction_entity.Transaction, after transaction_entity.Transaction)) error {
+	iterator, err := s.transactionRepository.Each(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: filters,
+	})
+	if err != nil {
+		return err
+	}
+
+	for iterator.Next() {
+		transaction, err := iterator.Current()
+		if err != nil {
+			return err
+		}
+
+		if result := rules.Apply(transaction); result != transaction {
+			fn(transaction, result)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package rule_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
+
+	rule_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/repository"
+	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
+	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
+)
+
+type RuleService interface {
+	CreateRule(ctx context.Context, params *CreateRuleParams) (*CreateRuleResult, error)
+	GetRule(ctx context.Context, params *GetRuleParams) (*GetRuleResult, error)
+	ListRules(ctx context.Context, params *ListRulesParams) (*ListRulesResult, error)
+	UpdateRule(ctx context.Context, params *UpdateRuleParams) (*UpdateRuleResult, error)
+	DeleteRule(ctx context.Context, params *DeleteRuleParams) (*DeleteRuleResult, error)
+	DryRunRules(ctx context.Context, params *DryRunRulesParams) (*DryRunRulesResult, error)
+	ReapplyRules(ctx context.Context, params *ReapplyRulesParams) (*ReapplyRulesResult, error)
+}
+
+type RuleServiceImpl struct {
+	ruleRepository        rule_repository.RuleRepository
+	transactionRepository transaction_repository.TransactionRepository
+	transactionService    transaction_service.TransactionService
+	transactionManager    transaction_manager.TransactionManager
+	logger                logger.Logger
+}
+
+func New(
+	logger logger.Logger,
+	ruleRepository rule_repository.RuleRepository,
+	transactionRepository transaction_repository.TransactionRepository,
+	transactionService transaction_service.TransactionService,
+	transactionManager transaction_manager.TransactionManager) RuleService {
+	return &RuleServiceImpl{
+		ruleRepository:        ruleRepository,
+		transactionRepository: transactionRepository,
+		transactionService:    transactionService,
+		transactionManager:    transactionManager,
+		logger:                logger,
+	}
+}
@@ -0,0 +1,50 @@
+package rule_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+)
+
+type CreateRuleParams struct {
+	Name      string
+	Priority  int32
+	Condition rule_entity.Condition
+	Action    rule_entity.Action
+}
+
+type CreateRuleResult struct {
+	Rule rule_entity.Rule
+}
+
+func (s *RuleServiceImpl) CreateRule(ctx context.Context, params *CreateRuleParams) (*CreateRuleResult, error) {
+	now := time.Now()
+	rule := rule_entity.Rule{
+		ID:        uuid.New(),
+		Name:      params.Name,
+		Priority:  params.Priority,
+		Condition: params.Condition,
+		Action:    params.Action,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkName(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepository.Save(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return &CreateRuleResult{
+		Rule: rule,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package rule_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	rule_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/errors"
+	rule_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/specification"
+)
+
+type DeleteRuleParams struct {
+	ID uuid.UUID
+}
+
+type DeleteRuleResult struct{}
+
+func (s *RuleServiceImpl) DeleteRule(ctx context.Context, params *DeleteRuleParams) (*DeleteRuleResult, error) {
+	rule, err := s.ruleRepository.Get(ctx, rule_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if rule == rule_entity.NoRule {
+		return nil, rule_errors.ErrRuleNotFound
+	}
+
+	if err := s.ruleRepository.Delete(ctx, rule_specification.WithID(rule.ID)); err != nil {
+		return nil, err
+	}
+
+	return &DeleteRuleResult{}, nil
+}
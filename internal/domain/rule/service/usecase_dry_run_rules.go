@@ -0,0 +1,59 @@
+package rule_service
+
+import (
+	"context"
+
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
+)
+
+type DryRunRulesParams struct {
+	transaction_service.FilterTransactionsParams
+	// Rule, when present, is evaluated on its own instead of the saved rules,
+	// so a rule can be tried out before it is created.
+	Rule *rule_entity.Rule
+}
+
+type RuleMatch struct {
+	Before transaction_entity.Transaction
+	After  transaction_entity.Transaction
+}
+
+type DryRunRulesResult struct {
+	Matches []RuleMatch
+}
+
+func (s *RuleServiceImpl) DryRunRules(ctx context.Context, params *DryRunRulesParams) (*DryRunRulesResult, error) {
+	rules := rule_entity.Rules{}
+
+	if params.Rule != nil {
+		if err := s.validateRule(*params.Rule); err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, *params.Rule)
+	} else {
+		saved, err := s.listRules(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = saved
+	}
+
+	result := &DryRunRulesResult{
+		Matches: []RuleMatch{},
+	}
+
+	if err := s.eachChange(ctx, rules, params.Specifications(), func(before transaction_entity.Transaction, after transaction_entity.Transaction) {
+		result.Matches = append(result.Matches, RuleMatch{
+			Before: before,
+			After:  after,
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package rule_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	rule_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/errors"
+	rule_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/specification"
+)
+
+type GetRuleParams struct {
+	ID uuid.UUID
+}
+
+type GetRuleResult struct {
+	Rule rule_entity.Rule
+}
+
+func (s *RuleServiceImpl) GetRule(ctx context.Context, params *GetRuleParams) (*GetRuleResult, error) {
+	rule, err := s.ruleRepository.Get(ctx, rule_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if rule == rule_entity.NoRule {
+		return nil, rule_errors.ErrRuleNotFound
+	}
+
+	return &GetRuleResult{
+		Rule: rule,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package rule_service
+
+import (
+	"context"
+
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+)
+
+type ListRulesParams struct {
+	Pagination common_service.PaginationParams
+}
+
+type ListRulesResult struct {
+	Pagination common_service.PaginationResult
+	Rules      []rule_entity.Rule
+}
+
+// ListRules returns the rules in evaluation order. Rule sets are small, so they
+// are sorted and paginated in memory.
+func (s *RuleServiceImpl) ListRules(ctx context.Context, params *ListRulesParams) (*ListRulesResult, error) {
+	rules, err := s.listRules(ctx)
+	if err != nil {
+		s.logger.Error("rule repository list error", "detail", err.Error())
+		return nil, err
+	}
+
+	rules = rules.Sorted()
+	params.Pagination = params.Pagination.Normalize()
+
+	size := uint32(len(rules))
+	start := min(params.Pagination.Offset(), size)
+	end := min(start+params.Pagination.Limit(), size)
+
+	return &ListRulesResult{
+		Pagination: common_service.NewPaginationResult(params.Pagination, size),
+		Rules:      rules[start:end],
+	}, nil
+}
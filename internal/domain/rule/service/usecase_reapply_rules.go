@@ -0,0 +1,57 @@
+package rule_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type ReapplyRulesParams struct {
+	transaction_service.FilterTransactionsParams
+}
+
+type ReapplyRulesResult struct {
+	Updated uint32
+}
+
+// ReapplyRules saves every transaction the current rules would change. The
+// changes are collected first and saved through the transaction service, so
+// the ledger follows the rewritten descriptions. Reconciled transactions are
+// locked and left as they are.
+func (s *RuleServiceImpl) ReapplyRules(ctx context.Context, params *ReapplyRulesParams) (*ReapplyRulesResult, error) {
+	rules, err := s.listRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := append(params.Specifications(), transaction_specification.ReconciledIs(false))
+
+	ids := []uuid.UUID{}
+	if err := s.eachChange(ctx, rules, specs, func(before transaction_entity.Transaction, _ transaction_entity.Transaction) {
+		ids = append(ids, before.ID)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		for _, id := range ids {
+			if _, err := s.transactionService.UpdateTransaction(ctx, &transaction_service.UpdateTransactionParams{
+				ID: id,
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ReapplyRulesResult{
+		Updated: uint32(len(ids)),
+	}, nil
+}
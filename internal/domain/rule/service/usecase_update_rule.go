@@ -0,0 +1,70 @@
+package rule_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	rule_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/errors"
+	rule_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/specification"
+)
+
+type UpdateRuleParams struct {
+	ID        uuid.UUID
+	Name      common_types.Maybe[string]
+	Priority  common_types.Maybe[int32]
+	Condition common_types.Maybe[rule_entity.Condition]
+	Action    common_types.Maybe[rule_entity.Action]
+}
+
+type UpdateRuleResult struct {
+	Rule rule_entity.Rule
+}
+
+func (s *RuleServiceImpl) UpdateRule(ctx context.Context, params *UpdateRuleParams) (*UpdateRuleResult, error) {
+	rule, err := s.ruleRepository.Get(ctx, rule_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if rule == rule_entity.NoRule {
+		return nil, rule_errors.ErrRuleNotFound
+	}
+
+	if params.Name.Present {
+		rule.Name = params.Name.Value
+	}
+
+	if params.Priority.Present {
+		rule.Priority = params.Priority.Value
+	}
+
+	if params.Condition.Present {
+		rule.Condition = params.Condition.Value
+	}
+
+	if params.Action.Present {
+		rule.Action = params.Action.Value
+	}
+
+	if err := s.validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkName(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	rule.UpdatedAt = time.Now()
+
+	if err := s.ruleRepository.Save(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return &UpdateRuleResult{
+		Rule: rule,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package rule_specification
+
+import (
+	"github.com/google/uuid"
+
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+)
+
+type RuleSpecification interface {
+	Call(rule rule_entity.Rule) bool
+}
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(rule rule_entity.Rule) bool {
+	return spec.ID == rule.ID
+}
+
+func WithID(id uuid.UUID) RuleSpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type WithoutIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithoutIDSpecification) Call(rule rule_entity.Rule) bool {
+	return spec.ID != rule.ID
+}
+
+func WithoutID(id uuid.UUID) RuleSpecification {
+	return WithoutIDSpecification{
+		ID: id,
+	}
+}
+
+type NameIsSpecification struct {
+	Name string
+}
+
+func (spec NameIsSpecification) Call(rule rule_entity.Rule) bool {
+	return spec.Name == rule.Name
+}
+
+func NameIs(name string) RuleSpecification {
+	return NameIsSpecification{
+		Name: name,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	echo "github.com/labstack/echo/v4"
 
+	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
 	subscription_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/service"
 	subscription_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/types"
 )
@@ -21,6 +22,10 @@ type SubscriptionController interface {
 	CancelSubscription(c echo.Context) error
 	GetSubscription(c echo.Context) error
 	ListSubscriptions(c echo.Context) error
+	ListCharges(c echo.Context) error
+	TagSubscription(c echo.Context) error
+	SuggestSubscriptions(c echo.Context) error
+	AcceptSuggestion(c echo.Context) error
 }
 
 type SubscriptionControllerImpl struct {
@@ -33,6 +38,10 @@ func (ctl *SubscriptionControllerImpl) Register(e *echo.Echo) {
 	e.DELETE("/v1/subscriptions/:id", ctl.CancelSubscription)
 	e.GET("/v1/subscriptions/:id", ctl.GetSubscription)
 	e.GET("/v1/subscriptions", ctl.ListSubscriptions)
+	e.GET("/v1/subscriptions/:id/charges", ctl.ListCharges)
+	e.PUT("/v1/subscriptions/:id/tags", ctl.TagSubscription)
+	e.GET("/v1/subscriptions/suggestions", ctl.SuggestSubscriptions)
+	e.POST("/v1/subscriptions/suggestions/:id/accept", ctl.AcceptSuggestion)
 }
 
 func (ctl *SubscriptionControllerImpl) CancelSubscription(c echo.Context) error {
@@ -61,12 +70,16 @@ func (ctl *SubscriptionControllerImpl) CreateSubscription(c echo.Context) error
 	}
 
 	result, err := ctl.subscriptionService.CreateSubscription(c.Request().Context(), &subscription_service.CreateSubscriptionParams{
-		Name:      requestJSON.Subscription.Name,
-		Fee:       requestJSON.Subscription.Fee,
-		Type:      subscription_types.GetType(requestJSON.Subscription.Type),
-		StartedAt: requestJSON.Subscription.StartedAt,
-		EndedAt:   requestJSON.Subscription.EndedAt,
-		DueAt:     requestJSON.Subscription.DueAt,
+		AccountID:  requestJSON.Subscription.AccountID,
+		CategoryID: requestJSON.Subscription.CategoryID,
+		Name:       requestJSON.Subscription.Name,
+		Fee:        requestJSON.Subscription.Fee,
+		Currency:   requestJSON.Subscription.Currency,
+		Type:       subscription_types.GetType(requestJSON.Subscription.Type),
+		StartedAt:  requestJSON.Subscription.StartedAt,
+		EndedAt:    requestJSON.Subscription.EndedAt,
+		DueAt:      requestJSON.Subscription.DueAt,
+		Tags:       requestJSON.Subscription.Tags,
 	})
 
 	if err != nil {
@@ -74,7 +87,7 @@ func (ctl *SubscriptionControllerImpl) CreateSubscription(c echo.Context) error
 	}
 
 	response := &CreateSubscriptionResponse{
-		Subscription: NewSubscriptionResponse(result.Subscription),
+		Subscription: NewSubscriptionResponse(result.Subscription, result.Tags, subscription_entity.NoSpend),
 	}
 
 	return c.JSON(http.StatusCreated, response)
@@ -96,7 +109,7 @@ func (ctl *SubscriptionControllerImpl) GetSubscription(c echo.Context) error {
 	}
 
 	response := &GetSubscriptionResponse{
-		Subscription: NewSubscriptionResponse(result.Subscription),
+		Subscription: NewSubscriptionResponse(result.Subscription, result.Tags, result.Spend),
 	}
 
 	return c.JSON(http.StatusOK, response)
@@ -126,6 +139,16 @@ func (ctl *SubscriptionControllerImpl) ListSubscriptions(c echo.Context) error {
 			params.TypeIs = subscription_types.GetType(values[0])
 			return nil
 		}).
+		CustomFunc("category_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.CategoryIs = id
+			return nil
+		}).
+		Strings("tags_any", &params.HasAnyTag).
+		Strings("tags_all", &params.HasAllTags).
 		FailFast(true).
 		BindError(); err != nil {
 		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
@@ -139,12 +162,134 @@ func (ctl *SubscriptionControllerImpl) ListSubscriptions(c echo.Context) error {
 
 	response := &ListSubscriptionsResponse{
 		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
-		Subscriptions:      NewSubscriptionsResponse(result.Subscriptions),
+		Subscriptions:      NewSubscriptionsResponse(result.Subscriptions, result.Tags, result.Spends),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *SubscriptionControllerImpl) ListCharges(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	params := &subscription_service.ListChargesParams{
+		ID:         id,
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.subscriptionService.ListCharges(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListChargesResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Charges:            NewChargesResponse(result.Charges, result.Refunds),
+		Total:              NewChargesTotalResponse(result.Spend),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *SubscriptionControllerImpl) TagSubscription(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &TagSubscriptionRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.subscriptionService.TagSubscription(c.Request().Context(), &subscription_service.TagSubscriptionParams{
+		ID:   id,
+		Tags: requestJSON.Tags,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &TagSubscriptionResponse{
+		Subscription: NewSubscriptionResponse(result.Subscription, result.Tags, result.Spend),
 	}
 
 	return c.JSON(http.StatusOK, response)
 }
 
+func (ctl *SubscriptionControllerImpl) SuggestSubscriptions(c echo.Context) error {
+	params := &subscription_service.SuggestSubscriptionsParams{}
+
+	if err := echo.QueryParamsBinder(c).
+		CustomFunc("account_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.AccountIs = id
+			return nil
+		}).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.subscriptionService.SuggestSubscriptions(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &SuggestSubscriptionsResponse{
+		Suggestions: NewSuggestionsResponse(result.Suggestions),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *SubscriptionControllerImpl) AcceptSuggestion(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &AcceptSuggestionRequest{}
+
+	if c.Request().ContentLength != 0 {
+		if err := c.Bind(requestJSON); err != nil {
+			return common_errors.ErrBadRequest
+		}
+	}
+
+	result, err := ctl.subscriptionService.AcceptSuggestion(c.Request().Context(), &subscription_service.AcceptSuggestionParams{
+		ID:         id,
+		Name:       requestJSON.Subscription.Name,
+		CategoryID: requestJSON.Subscription.CategoryID,
+		Tags:       requestJSON.Subscription.Tags,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &CreateSubscriptionResponse{
+		Subscription: NewSubscriptionResponse(result.Subscription, result.Tags, subscription_entity.NoSpend),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
 func New(logger logger.Logger, subscriptionService subscription_service.SubscriptionService) SubscriptionController {
 	return &SubscriptionControllerImpl{
 		logger:              logger,
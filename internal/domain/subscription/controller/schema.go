@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 
 	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
 )
 
 type MaybeTime time.Time
@@ -22,15 +24,22 @@ func (t MaybeTime) MarshalJSON() ([]byte, error) {
 }
 
 type SubscriptionResponse struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	Fee       int32     `json:"fee"`
-	Type      string    `json:"type"`
-	StartedAt time.Time `json:"started_at"`
-	EndedAt   MaybeTime `json:"ended_at"`
-	DueAt     time.Time `json:"due_at"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         uuid.UUID     `json:"id"`
+	AccountID  uuid.UUID     `json:"account_id"`
+	CategoryID uuid.NullUUID `json:"category_id"`
+	Name       string        `json:"name"`
+	Fee        int64         `json:"fee"`
+	Currency   string        `json:"currency"`
+	Type       string        `json:"type"`
+	StartedAt  time.Time     `json:"started_at"`
+	EndedAt    MaybeTime     `json:"ended_at"`
+	DueAt      time.Time     `json:"due_at"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+	Tags       []string      `json:"tags"`
+	// LifetimeSpend totals every charge so far, in the currency of the fee.
+	LifetimeSpend int64     `json:"lifetime_spend"`
+	LastChargedAt MaybeTime `json:"last_charged_at"`
 }
 
 type SubscriptionsResponse []SubscriptionResponse
@@ -41,12 +50,16 @@ type ListSubscriptionsResponse struct {
 }
 
 type SubscriptionRequest struct {
-	Name      string    `json:"name"`
-	Fee       int32     `json:"fee"`
-	Type      string    `json:"type"`
-	StartedAt time.Time `json:"started_at"`
-	EndedAt   time.Time `json:"ended_at"`
-	DueAt     time.Time `json:"due_at"`
+	AccountID  uuid.UUID `json:"account_id"`
+	CategoryID uuid.UUID `json:"category_id"`
+	Name       string    `json:"name"`
+	Fee        int64     `json:"fee"`
+	Currency   string    `json:"currency"`
+	Type       string    `json:"type"`
+	StartedAt  time.Time `json:"started_at"`
+	EndedAt    time.Time `json:"ended_at"`
+	DueAt      time.Time `json:"due_at"`
+	Tags       []string  `json:"tags"`
 }
 
 type CreateSubscriptionRequest struct {
@@ -61,26 +74,167 @@ type GetSubscriptionResponse struct {
 	Subscription SubscriptionResponse `json:"subscription"`
 }
 
-func NewSubscriptionResponse(subscription subscription_entity.Subscription) SubscriptionResponse {
+type TagSubscriptionRequest struct {
+	Tags []string `json:"tags"`
+}
+
+type TagSubscriptionResponse struct {
+	Subscription SubscriptionResponse `json:"subscription"`
+}
+
+func NewSubscriptionResponse(subscription subscription_entity.Subscription, tags tag_entity.Tags, spend subscription_entity.Spend) SubscriptionResponse {
 	return SubscriptionResponse{
 		ID:        subscription.ID,
+		AccountID: subscription.AccountID,
+		CategoryID: uuid.NullUUID{
+			UUID:  subscription.CategoryID,
+			Valid: subscription.CategoryID != uuid.Nil,
+		},
 		Name:      subscription.Name,
-		Fee:       subscription.Fee,
+		Fee:       subscription.Fee.Amount,
+		Currency:  subscription.Fee.Currency,
 		Type:      subscription.Type.String(),
 		StartedAt: subscription.StartedAt,
 		EndedAt:   MaybeTime(subscription.EndedAt),
 		DueAt:     subscription.DueAt,
 		CreatedAt: subscription.CreatedAt,
 		UpdatedAt: subscription.UpdatedAt,
+		Tags:      tags.Names(),
+
+		LifetimeSpend: spend.Lifetime.Amount,
+		LastChargedAt: MaybeTime(spend.LastChargedAt),
 	}
 }
 
-func NewSubscriptionsResponse(subscriptions subscription_entity.Subscriptions) SubscriptionsResponse {
+func NewSubscriptionsResponse(subscriptions subscription_entity.Subscriptions, tags map[uuid.UUID]tag_entity.Tags, spends map[uuid.UUID]subscription_entity.Spend) SubscriptionsResponse {
 	subscriptionsResponse := SubscriptionsResponse{}
 
 	for _, s := range subscriptions {
-		subscriptionsResponse = append(subscriptionsResponse, NewSubscriptionResponse(s))
+		subscriptionsResponse = append(subscriptionsResponse, NewSubscriptionResponse(s, tags[s.ID], spends[s.ID]))
 	}
 
 	return subscriptionsResponse
 }
+
+type ChargeResponse struct {
+	ID          uuid.UUID `json:"id"`
+	AccountID   uuid.UUID `json:"account_id"`
+	Description string    `json:"description"`
+	Amount      int64     `json:"amount"`
+	Currency    string    `json:"currency"`
+	CreatedAt   time.Time `json:"created_at"`
+	// Refunded totals the refunds of the charge.
+	Refunded int64 `json:"refunded"`
+}
+
+type ChargesResponse []ChargeResponse
+
+// ChargesTotalResponse totals every charge, the amount being net of the
+// refunded amount.
+type ChargesTotalResponse struct {
+	Charges       int       `json:"charges"`
+	Refunds       int       `json:"refunds"`
+	Refunded      int64     `json:"refunded"`
+	Amount        int64     `json:"amount"`
+	Currency      string    `json:"currency"`
+	LastChargedAt MaybeTime `json:"last_charged_at"`
+}
+
+type ListChargesResponse struct {
+	common_schema.PaginationResponse
+	Charges ChargesResponse      `json:"charges"`
+	Total   ChargesTotalResponse `json:"total"`
+}
+
+func NewChargesResponse(charges transaction_entity.Transactions, refunds map[uuid.UUID]transaction_entity.Transactions) ChargesResponse {
+	chargesResponse := ChargesResponse{}
+
+	for _, c := range charges {
+		chargesResponse = append(chargesResponse, ChargeResponse{
+			ID:          c.ID,
+			AccountID:   c.AccountID,
+			Description: c.Description,
+			Amount:      c.Amount.Amount,
+			Currency:    c.Amount.Currency,
+			CreatedAt:   c.CreatedAt,
+			Refunded:    c.Amount.Amount - c.NetAmount(refunds[c.ID]).Amount,
+		})
+	}
+
+	return chargesResponse
+}
+
+func NewChargesTotalResponse(spend subscription_entity.Spend) ChargesTotalResponse {
+	return ChargesTotalResponse{
+		Charges:       spend.Charges,
+		Refunds:       spend.Refunds,
+		Refunded:      spend.Refunded.Amount,
+		Amount:        spend.Lifetime.Amount,
+		Currency:      spend.Lifetime.Currency,
+		LastChargedAt: MaybeTime(spend.LastChargedAt),
+	}
+}
+
+type SuggestionResponse struct {
+	ID             uuid.UUID     `json:"id"`
+	AccountID      uuid.UUID     `json:"account_id"`
+	CategoryID     uuid.NullUUID `json:"category_id"`
+	PayeeID        uuid.NullUUID `json:"payee_id"`
+	Name           string        `json:"name"`
+	Fee            int64         `json:"fee"`
+	Currency       string        `json:"currency"`
+	Type           string        `json:"type"`
+	Occurrences    int           `json:"occurrences"`
+	FirstChargedAt time.Time     `json:"first_charged_at"`
+	LastChargedAt  time.Time     `json:"last_charged_at"`
+	DueAt          time.Time     `json:"due_at"`
+}
+
+type SuggestionsResponse []SuggestionResponse
+
+type SuggestSubscriptionsResponse struct {
+	Suggestions SuggestionsResponse `json:"suggestions"`
+}
+
+type AcceptSuggestionFieldsRequest struct {
+	Name       string    `json:"name"`
+	CategoryID uuid.UUID `json:"category_id"`
+	Tags       []string  `json:"tags"`
+}
+
+type AcceptSuggestionRequest struct {
+	Subscription AcceptSuggestionFieldsRequest `json:"subscription"`
+}
+
+func NewSuggestionResponse(suggestion subscription_entity.Suggestion) SuggestionResponse {
+	return SuggestionResponse{
+		ID:        suggestion.ID,
+		AccountID: suggestion.AccountID,
+		CategoryID: uuid.NullUUID{
+			UUID:  suggestion.CategoryID,
+			Valid: suggestion.CategoryID != uuid.Nil,
+		},
+		PayeeID: uuid.NullUUID{
+			UUID:  suggestion.PayeeID,
+			Valid: suggestion.PayeeID != uuid.Nil,
+		},
+		Name:           suggestion.Name,
+		Fee:            suggestion.Fee.Amount,
+		Currency:       suggestion.Fee.Currency,
+		Type:           suggestion.Type.String(),
+		Occurrences:    suggestion.Occurrences,
+		FirstChargedAt: suggestion.FirstChargedAt,
+		LastChargedAt:  suggestion.LastChargedAt,
+		DueAt:          suggestion.DueAt,
+	}
+}
+
+func NewSuggestionsResponse(suggestions subscription_entity.Suggestions) SuggestionsResponse {
+	suggestionsResponse := SuggestionsResponse{}
+
+	for _, s := range suggestions {
+		suggestionsResponse = append(suggestionsResponse, NewSuggestionResponse(s))
+	}
+
+	return suggestionsResponse
+}
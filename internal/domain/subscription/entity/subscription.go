@@ -6,19 +6,22 @@ import (
 
 	"github.com/google/uuid"
 
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
 	subscription_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/types"
 )
 
 type Subscription struct {
-	ID        uuid.UUID
-	Name      string
-	Fee       int32
-	Type      subscription_types.Type
-	StartedAt time.Time
-	EndedAt   time.Time
-	DueAt     time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	CategoryID uuid.UUID
+	Name       string
+	Fee        common_types.Money
+	Type       subscription_types.Type
+	StartedAt  time.Time
+	EndedAt    time.Time
+	DueAt      time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 type Subscriptions []Subscription
@@ -26,6 +29,18 @@ type Subscriptions []Subscription
 var NoSubscription = Subscription{}
 var NoSubscriptions = []Subscription{}
 
+// Spend totals the transactions charging the fee of a subscription. Refunds of
+// the charges are counted apart and taken off the lifetime spend.
+type Spend struct {
+	Charges       int
+	Refunds       int
+	Refunded      common_types.Money
+	Lifetime      common_types.Money
+	LastChargedAt time.Time
+}
+
+var NoSpend = Spend{}
+
 func (s Subscription) GetTransactionDescription() string {
-	return fmt.Sprintf("Pembayaran biaya langganan untuk layanan %s, senilai %d.", s.Name, s.Fee)
+	return fmt.Sprintf("Pembayaran biaya langganan untuk layanan %s, senilai %s %s.", s.Name, s.Fee.Currency, s.Fee.Decimal())
 }
@@ -0,0 +1,180 @@
+package subscription_entity
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	subscription_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/types"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+// suggestionNamespace seeds the suggestion ids, so the same recurring charges
+// are always suggested under the same id.
+var suggestionNamespace = uuid.MustParse("5b0b8a4e-6f0e-4a55-9a55-1c4f3d0e7c21")
+
+// Suggestion is a subscription inferred from charges of the same amount,
+// paid to the same payee, that recur on a regular cadence.
+type Suggestion struct {
+	ID             uuid.UUID
+	AccountID      uuid.UUID
+	CategoryID     uuid.UUID
+	PayeeID        uuid.UUID
+	Name           string
+	Fee            common_types.Money
+	Type           subscription_types.Type
+	Occurrences    int
+	FirstChargedAt time.Time
+	LastChargedAt  time.Time
+	DueAt          time.Time
+}
+
+type Suggestions []Suggestion
+
+var NoSuggestion = Suggestion{}
+var NoSuggestions = []Suggestion{}
+
+// Subscription drafts the subscription the suggestion stands for.
+func (s Suggestion) Subscription() Subscription {
+	return Subscription{
+		AccountID:  s.AccountID,
+		CategoryID: s.CategoryID,
+		Name:       s.Name,
+		Fee:        s.Fee,
+		Type:       s.Type,
+		StartedAt:  s.FirstChargedAt,
+		DueAt:      s.DueAt,
+	}
+}
+
+type charges struct {
+	key       string
+	latest    transaction_entity.Transaction
+	chargedAt []time.Time
+}
+
+// RecurringCharges groups expenses by account, payee or normalized
+// description, and amount, to find the groups charged on a regular cadence.
+type RecurringCharges struct {
+	groups map[string]*charges
+}
+
+func NewRecurringCharges() *RecurringCharges {
+	return &RecurringCharges{
+		groups: map[string]*charges{},
+	}
+}
+
+func (r *RecurringCharges) Add(transaction transaction_entity.Transaction) {
+	if transaction.Direction != transaction_types.Expense || transaction.IsIgnored() {
+		return
+	}
+
+	payee := transaction.PayeeID.String()
+	if !transaction.HasPayee() {
+		payee = transaction_entity.NormalizeDescription(transaction.Description)
+	}
+
+	if payee == "" {
+		return
+	}
+
+	key := strings.Join([]string{transaction.AccountID.String(), payee, transaction.Amount.String()}, "|")
+
+	group, ok := r.groups[key]
+	if !ok {
+		group = &charges{key: key}
+		r.groups[key] = group
+	}
+
+	if group.latest == transaction_entity.NoTransaction || transaction.CreatedAt.After(group.latest.CreatedAt) {
+		group.latest = transaction
+	}
+
+	group.chargedAt = append(group.chargedAt, transaction.CreatedAt)
+}
+
+// Suggestions returns a suggestion for every group whose charges all recur on
+// the same cadence, at least three times or twice a year apart. Suggestions
+// are ordered by name, due dates are left to the caller.
+func (r *RecurringCharges) Suggestions() Suggestions {
+	suggestions := Suggestions{}
+
+	for _, group := range r.groups {
+		sort.Slice(group.chargedAt, func(i, j int) bool {
+			return group.chargedAt[i].Before(group.chargedAt[j])
+		})
+
+		cadence := detectCadence(group.chargedAt)
+		if cadence == subscription_types.NoType {
+			continue
+		}
+
+		suggestions = append(suggestions, Suggestion{
+			ID:             uuid.NewSHA1(suggestionNamespace, []byte(group.key+"|"+cadence.String())),
+			AccountID:      group.latest.AccountID,
+			CategoryID:     group.latest.CategoryID,
+			PayeeID:        group.latest.PayeeID,
+			Name:           strings.TrimSpace(group.latest.Description),
+			Fee:            group.latest.Amount.Abs(),
+			Type:           cadence,
+			Occurrences:    len(group.chargedAt),
+			FirstChargedAt: group.chargedAt[0],
+			LastChargedAt:  group.chargedAt[len(group.chargedAt)-1],
+		})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Name != suggestions[j].Name {
+			return suggestions[i].Name < suggestions[j].Name
+		}
+
+		return suggestions[i].ID.String() < suggestions[j].ID.String()
+	})
+
+	return suggestions
+}
+
+// detectCadence classifies the days between consecutive charges, tolerating
+// a few days of drift for the longer cadences, and reports the cadence only
+// when every interval agrees.
+func detectCadence(chargedAt []time.Time) subscription_types.Type {
+	cadence := subscription_types.NoType
+
+	for i := 1; i < len(chargedAt); i++ {
+		days := math.Round(chargedAt[i].Sub(chargedAt[i-1]).Hours() / 24)
+
+		interval := subscription_types.NoType
+		switch {
+		case days == 1:
+			interval = subscription_types.Daily
+		case days >= 6 && days <= 8:
+			interval = subscription_types.Weekly
+		case days >= 27 && days <= 33:
+			interval = subscription_types.Monthly
+		case days >= 358 && days <= 372:
+			interval = subscription_types.Yearly
+		}
+
+		if interval == subscription_types.NoType || (cadence != subscription_types.NoType && interval != cadence) {
+			return subscription_types.NoType
+		}
+
+		cadence = interval
+	}
+
+	if cadence == subscription_types.Yearly && len(chargedAt) >= 2 {
+		return cadence
+	}
+
+	if len(chargedAt) < 3 {
+		return subscription_types.NoType
+	}
+
+	return cadence
+}
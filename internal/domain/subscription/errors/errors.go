@@ -25,6 +25,12 @@ var (
 		Message: "Subscription already exists. Please use different name.",
 	}
 
+	ErrSubscriptionSuggestionNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "SUBSCRIPTION_SUGGESTION_NOT_FOUND_ERROR",
+		Message: "Subscription suggestion not found. It may have been accepted already, please list the suggestions again.",
+	}
+
 	ErrSubscriptionTypeInvalid = &common_errors.Error{
 		Code:    http.StatusUnprocessableEntity,
 		Reason:  "SUBSCRIPTION_TYPE_INVALID_ERROR",
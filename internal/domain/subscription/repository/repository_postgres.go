@@ -10,16 +10,22 @@ import (
 	"github.com/google/uuid"
 
 	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
 
+	category_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/repository"
 	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
 	subscription_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/specification"
 	subscription_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/types"
+	tag_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/repository"
 )
 
 type PostgresSubscriptionRow struct {
 	ID               uuid.NullUUID
+	AccountID        uuid.NullUUID
+	CategoryID       uuid.NullUUID
 	Name             sql.NullString
-	Fee              sql.NullInt32
+	Fee              sql.NullInt64
+	Currency         string
 	SubscriptionType sql.NullString
 	StartedAt        sql.NullTime
 	EndedAt          sql.NullTime
@@ -36,8 +42,11 @@ func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseMa
 		TableName: "subscriptions",
 		Schema: map[string]string{
 			"id":                postgres_repository.UUID,
+			"account_id":        postgres_repository.UUID,
+			"category_id":       postgres_repository.UUID,
 			"name":              postgres_repository.CharacterVarying,
-			"fee":               postgres_repository.Integer,
+			"fee":               postgres_repository.BigInt,
+			"currency":          postgres_repository.Character,
 			"subscription_type": postgres_repository.CharacterVarying,
 			"started_at":        postgres_repository.TimestampWithZone,
 			"ended_at":          postgres_repository.TimestampWithZone,
@@ -47,8 +56,11 @@ func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseMa
 		},
 		Columns: []string{
 			"id",
+			"account_id",
+			"category_id",
 			"name",
 			"fee",
+			"currency",
 			"subscription_type",
 			"started_at",
 			"ended_at",
@@ -64,6 +76,14 @@ func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseMa
 				switch v := spec.(type) {
 				case subscription_specification.WithIDSpecification:
 					where = append(where, squirrel.Eq{"id": v.ID})
+				case subscription_specification.AccountIsSpecification:
+					where = append(where, squirrel.Eq{"account_id": v.AccountID})
+				case subscription_specification.CategoryIsSpecification:
+					where = append(where, category_repository.PostgresCategoryIn("category_id", v.CategoryID))
+				case subscription_specification.HasAnyTagSpecification:
+					where = append(where, tag_repository.PostgresHasAnyTag(tag_repository.PostgresSubscriptionTagsTable, tag_repository.PostgresSubscriptionTagsColumn, v.Names))
+				case subscription_specification.HasAllTagsSpecification:
+					where = append(where, tag_repository.PostgresHasAllTags(tag_repository.PostgresSubscriptionTagsTable, tag_repository.PostgresSubscriptionTagsColumn, v.Names))
 				case subscription_specification.NameLikeSpecification:
 					where = append(where, squirrel.ILike{"name": v.Substring})
 				case subscription_specification.NameIsSpecification:
@@ -88,7 +108,7 @@ func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseMa
 		},
 		Scan: func(rows *sql.Rows) (PostgresSubscriptionRow, error) {
 			row := PostgresSubscriptionRow{}
-			if err := rows.Scan(&row.ID, &row.Name, &row.Fee, &row.SubscriptionType, &row.StartedAt, &row.EndedAt, &row.DueAt, &row.CreatedAt, &row.UpdatedAt); err != nil {
+			if err := rows.Scan(&row.ID, &row.AccountID, &row.CategoryID, &row.Name, &row.Fee, &row.Currency, &row.SubscriptionType, &row.StartedAt, &row.EndedAt, &row.DueAt, &row.CreatedAt, &row.UpdatedAt); err != nil {
 				return NoPostgresSubscriptionRow, err
 			}
 
@@ -96,15 +116,17 @@ func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseMa
 		},
 		Entity: func(row PostgresSubscriptionRow) subscription_entity.Subscription {
 			return subscription_entity.Subscription{
-				ID:        row.ID.UUID,
-				Name:      row.Name.String,
-				Fee:       row.Fee.Int32,
-				Type:      subscription_types.GetType(row.SubscriptionType.String),
-				StartedAt: row.StartedAt.Time,
-				EndedAt:   row.EndedAt.Time,
-				DueAt:     row.DueAt.Time,
-				CreatedAt: row.CreatedAt.Time,
-				UpdatedAt: row.UpdatedAt.Time,
+				ID:         row.ID.UUID,
+				AccountID:  row.AccountID.UUID,
+				CategoryID: row.CategoryID.UUID,
+				Name:       row.Name.String,
+				Fee:        common_types.NewMoney(row.Fee.Int64, row.Currency),
+				Type:       subscription_types.GetType(row.SubscriptionType.String),
+				StartedAt:  row.StartedAt.Time,
+				EndedAt:    row.EndedAt.Time,
+				DueAt:      row.DueAt.Time,
+				CreatedAt:  row.CreatedAt.Time,
+				UpdatedAt:  row.UpdatedAt.Time,
 			}
 		},
 		Row: func(subscription subscription_entity.Subscription) PostgresSubscriptionRow {
@@ -115,14 +137,23 @@ func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseMa
 					UUID:  subscription.ID,
 					Valid: true,
 				},
+				AccountID: uuid.NullUUID{
+					UUID:  subscription.AccountID,
+					Valid: subscription.AccountID != uuid.Nil,
+				},
+				CategoryID: uuid.NullUUID{
+					UUID:  subscription.CategoryID,
+					Valid: subscription.CategoryID != uuid.Nil,
+				},
 				Name: sql.NullString{
 					String: subscription.Name,
 					Valid:  exists.String(subscription.Name),
 				},
-				Fee: sql.NullInt32{
-					Int32: subscription.Fee,
-					Valid: exists.Number(uint32(subscription.Fee)),
+				Fee: sql.NullInt64{
+					Int64: subscription.Fee.Amount,
+					Valid: !subscription.Fee.IsZero(),
 				},
+				Currency: subscription.Fee.Currency,
 				SubscriptionType: sql.NullString{
 					String: subscriptionType,
 					Valid:  subscriptionType != "",
@@ -152,8 +183,11 @@ func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseMa
 		Values: func(row PostgresSubscriptionRow) []any {
 			return []any{
 				row.ID,
+				row.AccountID,
+				row.CategoryID,
 				row.Name,
 				row.Fee,
+				row.Currency,
 				row.SubscriptionType,
 				row.StartedAt,
 				row.EndedAt,
@@ -5,12 +5,20 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
 	common_values "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/values"
 	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
 	subscription_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/types"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/service"
+	tag_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/types"
 	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
-
-	"github.com/google/uuid"
+	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
 )
 
 func (s *SubscriptionServiceImpl) computeDueAt(subscription subscription_entity.Subscription, startFrom time.Time) time.Time {
@@ -21,30 +29,148 @@ func (s *SubscriptionServiceImpl) computeDueAt(subscription subscription_entity.
 		return startFrom.AddDate(0, 0, 7)
 	case subscription_types.Monthly:
 		return startFrom.AddDate(0, 1, 0)
+	case subscription_types.Yearly:
+		return startFrom.AddDate(1, 0, 0)
 	default:
 		return common_values.NoTime
 	}
 }
 
+// getRefunds returns the refunds of the charges by the id of the charge.
+func (s *SubscriptionServiceImpl) getRefunds(ctx context.Context, charges ...transaction_entity.Transaction) (map[uuid.UUID]transaction_entity.Transactions, error) {
+	refunds := map[uuid.UUID]transaction_entity.Transactions{}
+
+	ids := []uuid.UUID{}
+	for _, charge := range charges {
+		ids = append(ids, charge.ID)
+	}
+
+	if len(ids) == 0 {
+		return refunds, nil
+	}
+
+	reversals, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: []transaction_specification.TransactionSpecification{transaction_specification.ReversalOf(ids...)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reversal := range reversals {
+		refunds[reversal.ReversalOfID] = append(refunds[reversal.ReversalOfID], reversal)
+	}
+
+	return refunds, nil
+}
+
+// getSpends totals the charges of every subscription, whose lifetime spend is
+// in the currency of its fee.
+func (s *SubscriptionServiceImpl) getSpends(ctx context.Context, subscriptions ...subscription_entity.Subscription) (map[uuid.UUID]subscription_entity.Spend, error) {
+	spends := map[uuid.UUID]subscription_entity.Spend{}
+	ids := []uuid.UUID{}
+	for _, subscription := range subscriptions {
+		spends[subscription.ID] = subscription_entity.Spend{
+			Refunded: common_types.NewMoney(0, subscription.Fee.Currency),
+			Lifetime: common_types.NewMoney(0, subscription.Fee.Currency),
+		}
+		ids = append(ids, subscription.ID)
+	}
+
+	if len(ids) == 0 {
+		return spends, nil
+	}
+
+	iterator, err := s.transactionRepository.Each(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: []transaction_specification.TransactionSpecification{
+			transaction_specification.ChargedBySubscription(ids...),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for iterator.Next() {
+		transaction, err := iterator.Current()
+		if err != nil {
+			return nil, err
+		}
+
+		spend := spends[transaction.SubscriptionID]
+		if transaction.IsReversal() {
+			spend.Refunds++
+			spend.Refunded, err = spend.Refunded.Add(transaction.Amount)
+			if err != nil {
+				return nil, err
+			}
+
+			spend.Lifetime, err = spend.Lifetime.Sub(transaction.Amount)
+			if err != nil {
+				return nil, err
+			}
+
+			spends[transaction.SubscriptionID] = spend
+			continue
+		}
+
+		spend.Charges++
+		spend.Lifetime, err = spend.Lifetime.Add(transaction.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if transaction.CreatedAt.After(spend.LastChargedAt) {
+			spend.LastChargedAt = transaction.CreatedAt
+		}
+
+		spends[transaction.SubscriptionID] = spend
+	}
+
+	return spends, nil
+}
+
+func (s *SubscriptionServiceImpl) getTags(ctx context.Context, subscriptions ...subscription_entity.Subscription) (map[uuid.UUID]tag_entity.Tags, error) {
+	ids := []uuid.UUID{}
+	for _, subscription := range subscriptions {
+		ids = append(ids, subscription.ID)
+	}
+
+	result, err := s.tagService.GetTags(ctx, &tag_service.GetTagsParams{
+		Target:    tag_types.SubscriptionTarget,
+		TaggedIDs: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Tags, nil
+}
+
 func (s *SubscriptionServiceImpl) chargeSubscription(ctx context.Context, subscription subscription_entity.Subscription) (subscription_entity.Subscription, error) {
 	now := time.Now()
 	subscription.UpdatedAt = now
 	subscription.DueAt = s.computeDueAt(subscription, now)
 
-	transaction := transaction_entity.Transaction{
-		ID:          uuid.New(),
-		Description: subscription.GetTransactionDescription(),
-		Amount:      subscription.Fee,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-	}
-
 	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
 		if err := s.subscriptionRepository.Save(ctx, subscription); err != nil {
 			return err
 		}
 
-		if err := s.transactionRepository.Save(ctx, transaction); err != nil {
+		tags, err := s.getTags(ctx, subscription)
+		if err != nil {
+			return err
+		}
+
+		if _, err := s.transactionService.CreateTransaction(ctx, &transaction_service.CreateTransactionParams{
+			AccountID:      subscription.AccountID,
+			CategoryID:     subscription.CategoryID,
+			SubscriptionID: subscription.ID,
+			Description:    subscription.GetTransactionDescription(),
+			Amount:         subscription.Fee.Amount,
+			Currency:       subscription.Fee.Currency,
+			Direction:      transaction_types.Expense,
+			CreatedAt:      now,
+			Tags:           tags[subscription.ID].Names(),
+		}); err != nil {
 			return err
 		}
 
@@ -6,22 +6,36 @@ import (
 	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
 	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
 
+	account_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/repository"
+	category_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/repository"
+	payee_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/repository"
 	subscription_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/repository"
+	tag_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/service"
 	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
+	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
 )
 
 type SubscriptionService interface {
 	CreateSubscription(ctx context.Context, params *CreateSubscriptionParams) (*CreateSubscriptionResult, error)
 	GetSubscription(ctx context.Context, params *GetSubscriptionParams) (*GetSubscriptionResult, error)
 	ListSubscriptions(ctx context.Context, params *ListSubscriptionsParams) (*ListSubscriptionsResult, error)
+	ListCharges(ctx context.Context, params *ListChargesParams) (*ListChargesResult, error)
 	CancelSubscription(ctx context.Context, params *CancelSubscriptionParams) (*CancelSubscriptionResult, error)
 	ChargeSubscription(ctx context.Context, params *ChargeSubscriptionParams) (*ChargeSubscriptionResult, error)
 	ChargeSubscriptions(ctx context.Context, params *ChargeSubscriptionsParams) (*ChargeSubscriptionsResult, error)
+	TagSubscription(ctx context.Context, params *TagSubscriptionParams) (*TagSubscriptionResult, error)
+	SuggestSubscriptions(ctx context.Context, params *SuggestSubscriptionsParams) (*SuggestSubscriptionsResult, error)
+	AcceptSuggestion(ctx context.Context, params *AcceptSuggestionParams) (*AcceptSuggestionResult, error)
 }
 
 type SubscriptionServiceImpl struct {
 	subscriptionRepository subscription_repository.SubscriptionRepository
+	transactionService     transaction_service.TransactionService
 	transactionRepository  transaction_repository.TransactionRepository
+	payeeRepository        payee_repository.PayeeRepository
+	accountRepository      account_repository.AccountRepository
+	categoryRepository     category_repository.CategoryRepository
+	tagService             tag_service.TagService
 	transactionManager     transaction_manager.TransactionManager
 	logger                 logger.Logger
 }
@@ -29,11 +43,21 @@ type SubscriptionServiceImpl struct {
 func New(
 	logger logger.Logger,
 	subscriptionRepository subscription_repository.SubscriptionRepository,
+	transactionService transaction_service.TransactionService,
 	transactionRepository transaction_repository.TransactionRepository,
+	payeeRepository payee_repository.PayeeRepository,
+	accountRepository account_repository.AccountRepository,
+	categoryRepository category_repository.CategoryRepository,
+	tagService tag_service.TagService,
 	transactionManager transaction_manager.TransactionManager) SubscriptionService {
 	return &SubscriptionServiceImpl{
 		subscriptionRepository: subscriptionRepository,
+		transactionService:     transactionService,
 		transactionRepository:  transactionRepository,
+		payeeRepository:        payeeRepository,
+		accountRepository:      accountRepository,
+		categoryRepository:     categoryRepository,
+		tagService:             tagService,
 		transactionManager:     transactionManager,
 		logger:                 logger,
 	}
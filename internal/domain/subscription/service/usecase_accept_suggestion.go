@@ -0,0 +1,72 @@
+package subscription_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
+	subscription_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/errors"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+// AcceptSuggestionParams may override the name and category detected from
+// the charges.
+type AcceptSuggestionParams struct {
+	ID         uuid.UUID
+	Name       string
+	CategoryID uuid.UUID
+	Tags       []string
+}
+
+type AcceptSuggestionResult struct {
+	Subscription subscription_entity.Subscription
+	Tags         tag_entity.Tags
+}
+
+func (s *SubscriptionServiceImpl) AcceptSuggestion(ctx context.Context, params *AcceptSuggestionParams) (*AcceptSuggestionResult, error) {
+	suggestions, err := s.suggest(ctx, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion := subscription_entity.NoSuggestion
+	for _, candidate := range suggestions {
+		if candidate.ID == params.ID {
+			suggestion = candidate
+		}
+	}
+
+	if suggestion == subscription_entity.NoSuggestion {
+		return nil, subscription_errors.ErrSubscriptionSuggestionNotFound
+	}
+
+	if exists.String(params.Name) {
+		suggestion.Name = params.Name
+	}
+
+	if params.CategoryID != uuid.Nil {
+		suggestion.CategoryID = params.CategoryID
+	}
+
+	result, err := s.CreateSubscription(ctx, &CreateSubscriptionParams{
+		AccountID:  suggestion.AccountID,
+		CategoryID: suggestion.CategoryID,
+		Name:       suggestion.Name,
+		Fee:        suggestion.Fee.Amount,
+		Currency:   suggestion.Fee.Currency,
+		Type:       suggestion.Type,
+		StartedAt:  suggestion.FirstChargedAt,
+		DueAt:      suggestion.DueAt,
+		Tags:       params.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AcceptSuggestionResult{
+		Subscription: result.Subscription,
+		Tags:         result.Tags,
+	}, nil
+}
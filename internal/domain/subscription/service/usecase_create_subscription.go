@@ -6,36 +6,53 @@ import (
 
 	"github.com/google/uuid"
 
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
 	common_values "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/values"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	category_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/errors"
+	category_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/specification"
 	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
 	subscription_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/errors"
 	subscription_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/specification"
 	subscription_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/types"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/service"
+	tag_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
 )
 
 type CreateSubscriptionParams struct {
-	Name      string
-	Fee       int32
-	Type      subscription_types.Type
-	StartedAt time.Time
-	EndedAt   time.Time
-	DueAt     time.Time
+	AccountID  uuid.UUID
+	CategoryID uuid.UUID
+	Name       string
+	Fee        int64
+	Currency   string
+	Type       subscription_types.Type
+	StartedAt  time.Time
+	EndedAt    time.Time
+	DueAt      time.Time
+	Tags       []string
 }
 
 type CreateSubscriptionResult struct {
 	Subscription subscription_entity.Subscription
+	Tags         tag_entity.Tags
 }
 
 func (s *SubscriptionServiceImpl) CreateSubscription(ctx context.Context, params *CreateSubscriptionParams) (*CreateSubscriptionResult, error) {
 	now := time.Now()
 	subscription := subscription_entity.Subscription{
-		ID:        uuid.New(),
-		Name:      params.Name,
-		Fee:       params.Fee,
-		Type:      params.Type,
-		StartedAt: params.StartedAt,
-		EndedAt:   params.EndedAt,
-		DueAt:     params.DueAt,
+		ID:         uuid.New(),
+		AccountID:  params.AccountID,
+		CategoryID: params.CategoryID,
+		Name:       params.Name,
+		Type:       params.Type,
+		StartedAt:  params.StartedAt,
+		EndedAt:    params.EndedAt,
+		DueAt:      params.DueAt,
 	}
 
 	if subscription.Type == subscription_types.NoType {
@@ -49,6 +66,40 @@ func (s *SubscriptionServiceImpl) CreateSubscription(ctx context.Context, params
 	subscription.CreatedAt = now
 	subscription.UpdatedAt = now
 
+	account, err := s.accountRepository.Get(ctx, account_specification.WithID(subscription.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	if account == account_entity.NoAccount {
+		return nil, account_errors.ErrAccountNotFound
+	}
+
+	currency := params.Currency
+	if !exists.String(currency) {
+		currency = account.Currency
+	}
+
+	subscription.Fee = common_types.NewMoney(params.Fee, currency)
+	if err := subscription.Fee.Validate(); err != nil {
+		return nil, err
+	}
+
+	if subscription.Fee.Currency != account.Currency {
+		return nil, common_errors.ErrCurrencyMismatch
+	}
+
+	if subscription.CategoryID != uuid.Nil {
+		categoryExist, err := s.categoryRepository.Exist(ctx, category_specification.WithID(subscription.CategoryID))
+		if err != nil {
+			return nil, err
+		}
+
+		if !categoryExist {
+			return nil, category_errors.ErrCategoryNotFound
+		}
+	}
+
 	exist, err := s.subscriptionRepository.Exist(ctx, subscription_specification.NameIs(subscription.Name))
 	if err != nil {
 		return nil, err
@@ -58,11 +109,29 @@ func (s *SubscriptionServiceImpl) CreateSubscription(ctx context.Context, params
 		return nil, subscription_errors.ErrSubscriptionAlreadyExist
 	}
 
-	if err := s.subscriptionRepository.Save(ctx, subscription); err != nil {
+	tags := tag_entity.Tags{}
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		if err := s.subscriptionRepository.Save(ctx, subscription); err != nil {
+			return err
+		}
+
+		result, err := s.tagService.SetTags(ctx, &tag_service.SetTagsParams{
+			Target:   tag_types.SubscriptionTarget,
+			TaggedID: subscription.ID,
+			Names:    params.Tags,
+		})
+		if err != nil {
+			return err
+		}
+
+		tags = result.Tags
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
 	return &CreateSubscriptionResult{
 		Subscription: subscription,
-	}, nil}
-
+		Tags:         tags,
+	}, nil
+}
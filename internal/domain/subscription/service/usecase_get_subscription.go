@@ -8,6 +8,7 @@ import (
 	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
 	subscription_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/errors"
 	subscription_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/specification"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
 )
 
 type GetSubscriptionParams struct {
@@ -16,6 +17,8 @@ type GetSubscriptionParams struct {
 
 type GetSubscriptionResult struct {
 	Subscription subscription_entity.Subscription
+	Tags         tag_entity.Tags
+	Spend        subscription_entity.Spend
 }
 
 func (s *SubscriptionServiceImpl) GetSubscription(ctx context.Context, params *GetSubscriptionParams) (*GetSubscriptionResult, error) {
@@ -28,7 +31,19 @@ func (s *SubscriptionServiceImpl) GetSubscription(ctx context.Context, params *G
 		return nil, subscription_errors.ErrSubscriptionNotFound
 	}
 
+	tags, err := s.getTags(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	spends, err := s.getSpends(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
 	return &GetSubscriptionResult{
 		Subscription: subscription,
+		Tags:         tags[subscription.ID],
+		Spend:        spends[subscription.ID],
 	}, nil
 }
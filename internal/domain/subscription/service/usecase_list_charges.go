@@ -0,0 +1,88 @@
+package subscription_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
+	subscription_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/errors"
+	subscription_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/specification"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type ListChargesParams struct {
+	ID         uuid.UUID
+	Pagination common_service.PaginationParams
+}
+
+type ListChargesResult struct {
+	Pagination   common_service.PaginationResult
+	Subscription subscription_entity.Subscription
+	Charges      []transaction_entity.Transaction
+	// Refunds holds the refunds of the charges on the page by charge id.
+	Refunds map[uuid.UUID]transaction_entity.Transactions
+	// Spend totals every charge, not only those on the page.
+	Spend subscription_entity.Spend
+}
+
+// ListCharges lists the transactions charging the fee of the subscription,
+// the latest first. Refunds are not listed as charges but with the charge
+// they refund.
+func (s *SubscriptionServiceImpl) ListCharges(ctx context.Context, params *ListChargesParams) (*ListChargesResult, error) {
+	subscription, err := s.subscriptionRepository.Get(ctx, subscription_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription == subscription_entity.NoSubscription {
+		return nil, subscription_errors.ErrSubscriptionNotFound
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	filters := []transaction_specification.TransactionSpecification{
+		transaction_specification.ChargedBySubscription(subscription.ID),
+		transaction_specification.ReversalIs(false),
+	}
+
+	charges, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: filters,
+		Sort: common_specification.Sort(
+			common_specification.SortArg{Column: "created_at", Direction: "DESC"},
+			common_specification.SortArg{Column: "id", Direction: "ASC"},
+		),
+		Limit:  common_specification.WithLimit(params.Pagination.Limit()),
+		Offset: common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := s.transactionRepository.Size(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := s.getRefunds(ctx, charges...)
+	if err != nil {
+		return nil, err
+	}
+
+	spends, err := s.getSpends(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListChargesResult{
+		Pagination:   common_service.NewPaginationResult(params.Pagination, size),
+		Subscription: subscription,
+		Charges:      charges,
+		Refunds:      refunds,
+		Spend:        spends[subscription.ID],
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+	"github.com/google/uuid"
 
 	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
 	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
@@ -12,11 +13,15 @@ import (
 	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
 	subscription_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/specification"
 	subscription_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/types"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
 )
 
 type ListSubscriptionsParams struct {
 	NameLike    string
 	TypeIs      subscription_types.Type
+	CategoryIs  uuid.UUID
+	HasAnyTag   []string
+	HasAllTags  []string
 	StartedFrom time.Time
 	StartedTo   time.Time
 	EndedFrom   time.Time
@@ -33,6 +38,8 @@ type ListSubscriptionsParams struct {
 type ListSubscriptionsResult struct {
 	Pagination    common_service.PaginationResult
 	Subscriptions []subscription_entity.Subscription
+	Tags          map[uuid.UUID]tag_entity.Tags
+	Spends        map[uuid.UUID]subscription_entity.Spend
 }
 
 func (s *SubscriptionServiceImpl) ListSubscriptions(ctx context.Context, params *ListSubscriptionsParams) (*ListSubscriptionsResult, error) {
@@ -46,6 +53,18 @@ func (s *SubscriptionServiceImpl) ListSubscriptions(ctx context.Context, params
 		filters = append(filters, subscription_specification.TypeIs(params.TypeIs))
 	}
 
+	if params.CategoryIs != uuid.Nil {
+		filters = append(filters, subscription_specification.CategoryIs(params.CategoryIs))
+	}
+
+	if names := tag_entity.NormalizeNames(params.HasAnyTag); len(names) > 0 {
+		filters = append(filters, subscription_specification.HasAnyTag(names...))
+	}
+
+	if names := tag_entity.NormalizeNames(params.HasAllTags); len(names) > 0 {
+		filters = append(filters, subscription_specification.HasAllTags(names...))
+	}
+
 	if exists.Date(params.StartedFrom) && exists.Date(params.StartedTo) {
 		filters = append(filters, subscription_specification.StartedBetween(params.StartedFrom, params.StartedTo))
 	}
@@ -84,8 +103,20 @@ func (s *SubscriptionServiceImpl) ListSubscriptions(ctx context.Context, params
 		return nil, err
 	}
 
+	tags, err := s.getTags(ctx, subs...)
+	if err != nil {
+		return nil, err
+	}
+
+	spends, err := s.getSpends(ctx, subs...)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ListSubscriptionsResult{
 		Subscriptions: subs,
+		Tags:          tags,
+		Spends:        spends,
 		Pagination:    common_service.NewPaginationResult(params.Pagination, size),
 	}, nil
 }
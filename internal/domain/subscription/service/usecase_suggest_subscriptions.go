@@ -0,0 +1,147 @@
+package subscription_service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	payee_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/specification"
+	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
+	subscription_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/specification"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+type SuggestSubscriptionsParams struct {
+	AccountIs uuid.UUID
+}
+
+type SuggestSubscriptionsResult struct {
+	Suggestions subscription_entity.Suggestions
+}
+
+func (s *SubscriptionServiceImpl) SuggestSubscriptions(ctx context.Context, params *SuggestSubscriptionsParams) (*SuggestSubscriptionsResult, error) {
+	suggestions, err := s.suggest(ctx, params.AccountIs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SuggestSubscriptionsResult{
+		Suggestions: suggestions,
+	}, nil
+}
+
+// suggest scans the expenses for recurring charges. Charges that stopped,
+// i.e. missed more than one due date, and charges an active subscription
+// already accounts for are not suggested.
+func (s *SubscriptionServiceImpl) suggest(ctx context.Context, accountID uuid.UUID) (subscription_entity.Suggestions, error) {
+	filters := []transaction_specification.TransactionSpecification{
+		transaction_specification.DirectionIs(transaction_types.Expense),
+	}
+
+	if accountID != uuid.Nil {
+		filters = append(filters, transaction_specification.AccountIs(accountID))
+	}
+
+	iterator, err := s.transactionRepository.Each(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recurring := subscription_entity.NewRecurringCharges()
+	for iterator.Next() {
+		transaction, err := iterator.Current()
+		if err != nil {
+			return nil, err
+		}
+
+		recurring.Add(transaction)
+	}
+
+	subscriptions, err := s.subscriptionRepository.List(ctx, common_repository.ListArgs[subscription_specification.SubscriptionSpecification]{
+		Filters: []subscription_specification.SubscriptionSpecification{
+			subscription_specification.NotEnded(time.Now()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := recurring.Suggestions()
+	payees, err := s.getPayees(ctx, candidates...)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	suggestions := subscription_entity.Suggestions{}
+	for _, suggestion := range candidates {
+		if payee, ok := payees[suggestion.PayeeID]; ok {
+			suggestion.Name = payee.Name
+		}
+
+		suggestion.DueAt = s.computeDueAt(suggestion.Subscription(), suggestion.LastChargedAt)
+		if s.computeDueAt(suggestion.Subscription(), suggestion.DueAt).Before(now) {
+			continue
+		}
+
+		if isSubscribed(suggestion, subscriptions) {
+			continue
+		}
+
+		suggestions = append(suggestions, suggestion)
+	}
+
+	return suggestions, nil
+}
+
+func (s *SubscriptionServiceImpl) getPayees(ctx context.Context, suggestions ...subscription_entity.Suggestion) (map[uuid.UUID]payee_entity.Payee, error) {
+	payeesByID := map[uuid.UUID]payee_entity.Payee{}
+
+	ids := []uuid.UUID{}
+	for _, suggestion := range suggestions {
+		if suggestion.PayeeID != uuid.Nil {
+			ids = append(ids, suggestion.PayeeID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return payeesByID, nil
+	}
+
+	payees, err := s.payeeRepository.List(ctx, common_repository.ListArgs[payee_specification.PayeeSpecification]{
+		Filters: []payee_specification.PayeeSpecification{payee_specification.IDIn(ids...)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, payee := range payees {
+		payeesByID[payee.ID] = payee
+	}
+
+	return payeesByID, nil
+}
+
+// isSubscribed reports whether a subscription with the same name, or with the
+// same account, fee and cadence, already exists. The latter also keeps the
+// charges made by the subscriptions themselves from being suggested.
+func isSubscribed(suggestion subscription_entity.Suggestion, subscriptions []subscription_entity.Subscription) bool {
+	for _, subscription := range subscriptions {
+		if strings.EqualFold(subscription.Name, suggestion.Name) {
+			return true
+		}
+
+		if subscription.AccountID == suggestion.AccountID && subscription.Fee == suggestion.Fee && subscription.Type == suggestion.Type {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,57 @@
+package subscription_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	subscription_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/entity"
+	subscription_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/errors"
+	subscription_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/specification"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/service"
+	tag_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/types"
+)
+
+type TagSubscriptionParams struct {
+	ID   uuid.UUID
+	Tags []string
+}
+
+type TagSubscriptionResult struct {
+	Subscription subscription_entity.Subscription
+	Tags         tag_entity.Tags
+	Spend        subscription_entity.Spend
+}
+
+// TagSubscription replaces the tags of the subscription.
+func (s *SubscriptionServiceImpl) TagSubscription(ctx context.Context, params *TagSubscriptionParams) (*TagSubscriptionResult, error) {
+	subscription, err := s.subscriptionRepository.Get(ctx, subscription_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription == subscription_entity.NoSubscription {
+		return nil, subscription_errors.ErrSubscriptionNotFound
+	}
+
+	result, err := s.tagService.SetTags(ctx, &tag_service.SetTagsParams{
+		Target:   tag_types.SubscriptionTarget,
+		TaggedID: subscription.ID,
+		Names:    params.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	spends, err := s.getSpends(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TagSubscriptionResult{
+		Subscription: subscription,
+		Tags:         result.Tags,
+		Spend:        spends[subscription.ID],
+	}, nil
+}
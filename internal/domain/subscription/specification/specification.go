@@ -185,3 +185,65 @@ func WithID(id uuid.UUID) SubscriptionSpecification {
 		ID: id,
 	}
 }
+
+type AccountIsSpecification struct {
+	AccountID uuid.UUID
+}
+
+func (spec AccountIsSpecification) Call(subscription subscription_entity.Subscription) bool {
+	return spec.AccountID == subscription.AccountID
+}
+
+func AccountIs(accountID uuid.UUID) SubscriptionSpecification {
+	return AccountIsSpecification{
+		AccountID: accountID,
+	}
+}
+
+// CategoryIsSpecification matches subscriptions in the category. Repositories
+// that know the category tree also match its descendants.
+type CategoryIsSpecification struct {
+	CategoryID uuid.UUID
+}
+
+func (spec CategoryIsSpecification) Call(subscription subscription_entity.Subscription) bool {
+	return spec.CategoryID == subscription.CategoryID
+}
+
+func CategoryIs(categoryID uuid.UUID) SubscriptionSpecification {
+	return CategoryIsSpecification{
+		CategoryID: categoryID,
+	}
+}
+
+// HasAnyTagSpecification matches subscriptions tagged with at least one of the
+// names. Tags live outside the entity, so only repositories evaluate it.
+type HasAnyTagSpecification struct {
+	Names []string
+}
+
+func (spec HasAnyTagSpecification) Call(subscription subscription_entity.Subscription) bool {
+	return false
+}
+
+func HasAnyTag(names ...string) SubscriptionSpecification {
+	return HasAnyTagSpecification{
+		Names: names,
+	}
+}
+
+// HasAllTagsSpecification matches subscriptions tagged with every one of the
+// names. Tags live outside the entity, so only repositories evaluate it.
+type HasAllTagsSpecification struct {
+	Names []string
+}
+
+func (spec HasAllTagsSpecification) Call(subscription subscription_entity.Subscription) bool {
+	return false
+}
+
+func HasAllTags(names ...string) SubscriptionSpecification {
+	return HasAllTagsSpecification{
+		Names: names,
+	}
+}
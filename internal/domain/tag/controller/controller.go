@@ -0,0 +1,155 @@
+package tag_controller
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	echo "github.com/labstack/echo/v4"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	tag_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/service"
+)
+
+type TagController interface {
+	Register(*echo.Echo)
+	CreateTag(c echo.Context) error
+	GetTag(c echo.Context) error
+	ListTags(c echo.Context) error
+	UpdateTag(c echo.Context) error
+	DeleteTag(c echo.Context) error
+}
+
+type TagControllerImpl struct {
+	logger     logger.Logger
+	tagService tag_service.TagService
+}
+
+func (ctl *TagControllerImpl) Register(e *echo.Echo) {
+	e.POST("/v1/tags", ctl.CreateTag)
+	e.GET("/v1/tags", ctl.ListTags)
+	e.GET("/v1/tags/:id", ctl.GetTag)
+	e.PATCH("/v1/tags/:id", ctl.UpdateTag)
+	e.DELETE("/v1/tags/:id", ctl.DeleteTag)
+}
+
+func (ctl *TagControllerImpl) CreateTag(c echo.Context) error {
+	requestJSON := &CreateTagRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.tagService.CreateTag(c.Request().Context(), &tag_service.CreateTagParams{
+		Name: requestJSON.Tag.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &CreateTagResponse{
+		Tag: NewTagResponse(result.Tag),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (ctl *TagControllerImpl) GetTag(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	result, err := ctl.tagService.GetTag(c.Request().Context(), &tag_service.GetTagParams{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &GetTagResponse{
+		Tag: NewTagResponse(result.Tag),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *TagControllerImpl) ListTags(c echo.Context) error {
+	params := &tag_service.ListTagsParams{
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		String("name_like", &params.NameLike).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		ctl.logger.Error("PARSE_ERROR", logger.String("error", err.Error()))
+		return err
+	}
+
+	result, err := ctl.tagService.ListTags(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListTagsResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Tags:               NewTagsResponse(result.Tags),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *TagControllerImpl) UpdateTag(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &UpdateTagRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.tagService.UpdateTag(c.Request().Context(), &tag_service.UpdateTagParams{
+		ID:   id,
+		Name: requestJSON.Tag.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &UpdateTagResponse{
+		Tag: NewTagResponse(result.Tag),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *TagControllerImpl) DeleteTag(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	if _, err := ctl.tagService.DeleteTag(c.Request().Context(), &tag_service.DeleteTagParams{
+		ID: id,
+	}); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func New(logger logger.Logger, tagService tag_service.TagService) TagController {
+	return &TagControllerImpl{
+		logger:     logger,
+		tagService: tagService,
+	}
+}
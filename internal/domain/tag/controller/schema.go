@@ -0,0 +1,67 @@
+package tag_controller
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+)
+
+type TagResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TagsResponse []TagResponse
+
+type ListTagsResponse struct {
+	common_schema.PaginationResponse
+	Tags TagsResponse `json:"tags"`
+}
+
+type TagRequest struct {
+	Name string `json:"name"`
+}
+
+type CreateTagRequest struct {
+	Tag TagRequest `json:"tag"`
+}
+
+type CreateTagResponse struct {
+	Tag TagResponse `json:"tag"`
+}
+
+type UpdateTagRequest struct {
+	Tag TagRequest `json:"tag"`
+}
+
+type UpdateTagResponse struct {
+	Tag TagResponse `json:"tag"`
+}
+
+type GetTagResponse struct {
+	Tag TagResponse `json:"tag"`
+}
+
+func NewTagResponse(tag tag_entity.Tag) TagResponse {
+	return TagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+func NewTagsResponse(tags tag_entity.Tags) TagsResponse {
+	tagsResponse := TagsResponse{}
+
+	for _, t := range tags {
+		tagsResponse = append(tagsResponse, NewTagResponse(t))
+	}
+
+	return tagsResponse
+}
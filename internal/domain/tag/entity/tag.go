@@ -0,0 +1,70 @@
+package tag_entity
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Tag struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Tags []Tag
+
+var NoTag = Tag{}
+var NoTags = []Tag{}
+
+// Tagging links a tag to the transaction or subscription it is attached to.
+type Tagging struct {
+	ID        uuid.UUID
+	TagID     uuid.UUID
+	TaggedID  uuid.UUID
+	CreatedAt time.Time
+}
+
+type Taggings []Tagging
+
+var NoTagging = Tagging{}
+var NoTaggings = []Tagging{}
+
+// NormalizeName turns "#Trip-Bali-2026 " into "trip-bali-2026", so the same
+// tag is not created twice with different spelling.
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "#"))
+}
+
+// NormalizeNames normalizes every name and drops empty and repeated ones.
+func NormalizeNames(names []string) []string {
+	seen := map[string]bool{}
+	normalized := []string{}
+
+	for _, name := range names {
+		name = NormalizeName(name)
+		if name == "" || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		normalized = append(normalized, name)
+	}
+
+	sort.Strings(normalized)
+
+	return normalized
+}
+
+func (tags Tags) Names() []string {
+	names := []string{}
+
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,27 @@
+package tag_errors
+
+import (
+	"net/http"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+)
+
+var (
+	ErrTagNotFound = &common_errors.Error{
+		Code:    http.StatusNotFound,
+		Reason:  "TAG_NOT_FOUND_ERROR",
+		Message: "Tag not found. Please pass valid tag id.",
+	}
+
+	ErrTagAlreadyExist = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TAG_ALREADY_EXIST_ERROR",
+		Message: "Tag already exists. Please use different name.",
+	}
+
+	ErrTagNameEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TAG_NAME_EMPTY_ERROR",
+		Message: "Tag name is empty. Please pass non-empty name.",
+	}
+)
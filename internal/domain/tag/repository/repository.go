@@ -0,0 +1,11 @@
+package tag_repository
+
+import (
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+)
+
+type TagRepository common_repository.Repository[tag_entity.Tag, tag_specification.TagSpecification]
+
+type TaggingRepository common_repository.Repository[tag_entity.Tagging, tag_specification.TaggingSpecification]
@@ -0,0 +1,108 @@
+package tag_repository
+
+import (
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+)
+
+type PostgresTagRow struct {
+	ID        uuid.NullUUID
+	Name      sql.NullString
+	CreatedAt sql.NullTime
+	UpdatedAt sql.NullTime
+}
+
+var NoPostgresTagRow = PostgresTagRow{}
+
+func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (TagRepository, error) {
+	return postgres_repository.New[tag_entity.Tag, tag_specification.TagSpecification, PostgresTagRow](postgres_repository.Option[tag_entity.Tag, tag_specification.TagSpecification, PostgresTagRow]{
+		Logger:    logger,
+		TableName: "tags",
+		Schema: map[string]string{
+			"id":         postgres_repository.UUID,
+			"name":       postgres_repository.CharacterVarying,
+			"created_at": postgres_repository.TimestampWithZone,
+			"updated_at": postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"name",
+			"created_at",
+			"updated_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...tag_specification.TagSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case tag_specification.WithIDSpecification:
+					where = append(where, squirrel.Eq{"id": v.ID})
+				case tag_specification.WithoutIDSpecification:
+					where = append(where, squirrel.NotEq{"id": v.ID})
+				case tag_specification.IDInSpecification:
+					where = append(where, squirrel.Eq{"id": v.IDs})
+				case tag_specification.NameIsSpecification:
+					where = append(where, squirrel.Eq{"name": v.Name})
+				case tag_specification.NameLikeSpecification:
+					where = append(where, squirrel.ILike{"name": "%" + v.Substring + "%"})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (PostgresTagRow, error) {
+			row := PostgresTagRow{}
+			if err := rows.Scan(&row.ID, &row.Name, &row.CreatedAt, &row.UpdatedAt); err != nil {
+				return NoPostgresTagRow, err
+			}
+
+			return row, nil
+		},
+		Entity: func(row PostgresTagRow) tag_entity.Tag {
+			return tag_entity.Tag{
+				ID:        row.ID.UUID,
+				Name:      row.Name.String,
+				CreatedAt: row.CreatedAt.Time,
+				UpdatedAt: row.UpdatedAt.Time,
+			}
+		},
+		Row: func(tag tag_entity.Tag) PostgresTagRow {
+			return PostgresTagRow{
+				ID: uuid.NullUUID{
+					UUID:  tag.ID,
+					Valid: true,
+				},
+				Name: sql.NullString{
+					String: tag.Name,
+					Valid:  exists.String(tag.Name),
+				},
+				CreatedAt: sql.NullTime{
+					Time:  tag.CreatedAt,
+					Valid: exists.Date(tag.CreatedAt),
+				},
+				UpdatedAt: sql.NullTime{
+					Time:  tag.UpdatedAt,
+					Valid: exists.Date(tag.UpdatedAt),
+				},
+			}
+		},
+		Values: func(row PostgresTagRow) []any {
+			return []any{
+				row.ID,
+				row.Name,
+				row.CreatedAt,
+				row.UpdatedAt,
+			}
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package tag_repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+)
+
+const (
+	PostgresTransactionTagsTable   = "transaction_tags"
+	PostgresTransactionTagsColumn  = "transaction_id"
+	PostgresSubscriptionTagsTable  = "subscription_tags"
+	PostgresSubscriptionTagsColumn = "subscription_id"
+)
+
+type PostgresTaggingRow struct {
+	ID        uuid.UUID
+	TagID     uuid.UUID
+	TaggedID  uuid.UUID
+	CreatedAt sql.NullTime
+}
+
+// PostgresHasAnyTag matches rows tagged with at least one of the names through
+// the join table.
+func PostgresHasAnyTag(table string, column string, names []string) squirrel.Sqlizer {
+	return postgresTagged(squirrel.
+		Select(fmt.Sprintf("j.%s", column)).
+		From(fmt.Sprintf("%s j", table)).
+		Join("tags t ON t.id = j.tag_id").
+		Where(squirrel.Eq{"t.name": names}))
+}
+
+// PostgresHasAllTags matches rows tagged with every one of the names through
+// the join table.
+func PostgresHasAllTags(table string, column string, names []string) squirrel.Sqlizer {
+	return postgresTagged(squirrel.
+		Select(fmt.Sprintf("j.%s", column)).
+		From(fmt.Sprintf("%s j", table)).
+		Join("tags t ON t.id = j.tag_id").
+		Where(squirrel.Eq{"t.name": names}).
+		GroupBy(fmt.Sprintf("j.%s", column)).
+		Having("COUNT(DISTINCT t.name) = ?", len(names)))
+}
+
+func postgresTagged(subquery squirrel.SelectBuilder) squirrel.Sqlizer {
+	query, args, err := subquery.ToSql()
+	if err != nil {
+		return squirrel.Expr("FALSE")
+	}
+
+	return squirrel.Expr(fmt.Sprintf("id IN (%s)", query), args...)
+}
+
+func NewPostgresTransactionTaggingRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (TaggingRepository, error) {
+	return newPostgresTaggingRepository(logger, dbm, PostgresTransactionTagsTable, PostgresTransactionTagsColumn)
+}
+
+func NewPostgresSubscriptionTaggingRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (TaggingRepository, error) {
+	return newPostgresTaggingRepository(logger, dbm, PostgresSubscriptionTagsTable, PostgresSubscriptionTagsColumn)
+}
+
+func newPostgresTaggingRepository(logger logger.Logger, dbm database_manager.DatabaseManager, table string, column string) (TaggingRepository, error) {
+	return postgres_repository.New[tag_entity.Tagging, tag_specification.TaggingSpecification, *PostgresTaggingRow](postgres_repository.Option[tag_entity.Tagging, tag_specification.TaggingSpecification, *PostgresTaggingRow]{
+		Logger:    logger,
+		TableName: table,
+		Schema: map[string]string{
+			"id":         postgres_repository.UUID,
+			"tag_id":     postgres_repository.UUID,
+			column:       postgres_repository.UUID,
+			"created_at": postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"tag_id",
+			column,
+			"created_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...tag_specification.TaggingSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case tag_specification.TaggedIsSpecification:
+					where = append(where, squirrel.Eq{column: v.TaggedID})
+				case tag_specification.TaggedInSpecification:
+					where = append(where, squirrel.Eq{column: v.TaggedIDs})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresTaggingRow, error) {
+			row := &PostgresTaggingRow{}
+			if err := rows.Scan(&row.ID, &row.TagID, &row.TaggedID, &row.CreatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresTaggingRow) tag_entity.Tagging {
+			return tag_entity.Tagging{
+				ID:        row.ID,
+				TagID:     row.TagID,
+				TaggedID:  row.TaggedID,
+				CreatedAt: row.CreatedAt.Time,
+			}
+		},
+		Row: func(tagging tag_entity.Tagging) *PostgresTaggingRow {
+			return &PostgresTaggingRow{
+				ID:       tagging.ID,
+				TagID:    tagging.TagID,
+				TaggedID: tagging.TaggedID,
+				CreatedAt: sql.NullTime{
+					Time:  tagging.CreatedAt,
+					Valid: !tagging.CreatedAt.IsZero(),
+				},
+			}
+		},
+		Values: func(row *PostgresTaggingRow) []any {
+			return []any{
+				row.ID,
+				row.TagID,
+				row.TaggedID,
+				row.CreatedAt,
+			}
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package tag_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/errors"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+)
+
+func (s *TagServiceImpl) checkName(ctx context.Context, tag tag_entity.Tag) error {
+	if tag.Name == "" {
+		return tag_errors.ErrTagNameEmpty
+	}
+
+	exist, err := s.tagRepository.Exist(ctx, tag_specification.NameIs(tag.Name), tag_specification.WithoutID(tag.ID))
+	if err != nil {
+		return err
+	}
+
+	if exist {
+		return tag_errors.ErrTagAlreadyExist
+	}
+
+	return nil
+}
+
+// findOrCreateTag returns the tag with the normalized name, creating it when
+// nobody has used the name yet.
+func (s *TagServiceImpl) findOrCreateTag(ctx context.Context, name string) (tag_entity.Tag, error) {
+	tag, err := s.tagRepository.Get(ctx, tag_specification.NameIs(name))
+	if err != nil {
+		return tag_entity.NoTag, err
+	}
+
+	if tag != tag_entity.NoTag {
+		return tag, nil
+	}
+
+	now := time.Now()
+	tag = tag_entity.Tag{
+		ID:        uuid.New(),
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.tagRepository.Save(ctx, tag); err != nil {
+		return tag_entity.NoTag, err
+	}
+
+	return tag, nil
+}
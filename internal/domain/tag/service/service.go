@@ -0,0 +1,45 @@
+package tag_service
+
+import (
+	"context"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
+
+	tag_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/repository"
+	tag_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/types"
+)
+
+type TagService interface {
+	CreateTag(ctx context.Context, params *CreateTagParams) (*CreateTagResult, error)
+	GetTag(ctx context.Context, params *GetTagParams) (*GetTagResult, error)
+	ListTags(ctx context.Context, params *ListTagsParams) (*ListTagsResult, error)
+	UpdateTag(ctx context.Context, params *UpdateTagParams) (*UpdateTagResult, error)
+	DeleteTag(ctx context.Context, params *DeleteTagParams) (*DeleteTagResult, error)
+	SetTags(ctx context.Context, params *SetTagsParams) (*SetTagsResult, error)
+	GetTags(ctx context.Context, params *GetTagsParams) (*GetTagsResult, error)
+}
+
+type TagServiceImpl struct {
+	tagRepository       tag_repository.TagRepository
+	taggingRepositories map[tag_types.Target]tag_repository.TaggingRepository
+	transactionManager  transaction_manager.TransactionManager
+	logger              logger.Logger
+}
+
+func New(
+	logger logger.Logger,
+	tagRepository tag_repository.TagRepository,
+	transactionTaggingRepository tag_repository.TaggingRepository,
+	subscriptionTaggingRepository tag_repository.TaggingRepository,
+	transactionManager transaction_manager.TransactionManager) TagService {
+	return &TagServiceImpl{
+		tagRepository: tagRepository,
+		taggingRepositories: map[tag_types.Target]tag_repository.TaggingRepository{
+			tag_types.TransactionTarget:  transactionTaggingRepository,
+			tag_types.SubscriptionTarget: subscriptionTaggingRepository,
+		},
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
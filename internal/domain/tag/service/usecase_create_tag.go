@@ -0,0 +1,40 @@
+package tag_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+)
+
+type CreateTagParams struct {
+	Name string
+}
+
+type CreateTagResult struct {
+	Tag tag_entity.Tag
+}
+
+func (s *TagServiceImpl) CreateTag(ctx context.Context, params *CreateTagParams) (*CreateTagResult, error) {
+	now := time.Now()
+	tag := tag_entity.Tag{
+		ID:        uuid.New(),
+		Name:      tag_entity.NormalizeName(params.Name),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.checkName(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	if err := s.tagRepository.Save(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	return &CreateTagResult{
+		Tag: tag,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package tag_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/errors"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+)
+
+type DeleteTagParams struct {
+	ID uuid.UUID
+}
+
+type DeleteTagResult struct{}
+
+// DeleteTag removes the tag. Its taggings are removed by the database.
+func (s *TagServiceImpl) DeleteTag(ctx context.Context, params *DeleteTagParams) (*DeleteTagResult, error) {
+	tag, err := s.tagRepository.Get(ctx, tag_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if tag == tag_entity.NoTag {
+		return nil, tag_errors.ErrTagNotFound
+	}
+
+	if err := s.tagRepository.Delete(ctx, tag_specification.WithID(tag.ID)); err != nil {
+		return nil, err
+	}
+
+	return &DeleteTagResult{}, nil
+}
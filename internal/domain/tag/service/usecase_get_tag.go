@@ -0,0 +1,34 @@
+package tag_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/errors"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+)
+
+type GetTagParams struct {
+	ID uuid.UUID
+}
+
+type GetTagResult struct {
+	Tag tag_entity.Tag
+}
+
+func (s *TagServiceImpl) GetTag(ctx context.Context, params *GetTagParams) (*GetTagResult, error) {
+	tag, err := s.tagRepository.Get(ctx, tag_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if tag == tag_entity.NoTag {
+		return nil, tag_errors.ErrTagNotFound
+	}
+
+	return &GetTagResult{
+		Tag: tag,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package tag_service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+	tag_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/types"
+)
+
+type GetTagsParams struct {
+	Target    tag_types.Target
+	TaggedIDs []uuid.UUID
+}
+
+type GetTagsResult struct {
+	// Tags holds the tags of every tagged id, sorted by name. Ids without tags
+	// are left out.
+	Tags map[uuid.UUID]tag_entity.Tags
+}
+
+// GetTags loads the tags of many transactions or subscriptions at once, so a
+// page of records costs two queries.
+func (s *TagServiceImpl) GetTags(ctx context.Context, params *GetTagsParams) (*GetTagsResult, error) {
+	result := &GetTagsResult{
+		Tags: map[uuid.UUID]tag_entity.Tags{},
+	}
+
+	if len(params.TaggedIDs) == 0 {
+		return result, nil
+	}
+
+	taggings, err := s.taggingRepositories[params.Target].List(ctx, common_repository.ListArgs[tag_specification.TaggingSpecification]{
+		Filters: []tag_specification.TaggingSpecification{tag_specification.TaggedIn(params.TaggedIDs)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(taggings) == 0 {
+		return result, nil
+	}
+
+	tagIDs := []uuid.UUID{}
+	for _, tagging := range taggings {
+		tagIDs = append(tagIDs, tagging.TagID)
+	}
+
+	tags, err := s.tagRepository.List(ctx, common_repository.ListArgs[tag_specification.TagSpecification]{
+		Filters: []tag_specification.TagSpecification{tag_specification.IDIn(tagIDs)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tagByID := map[uuid.UUID]tag_entity.Tag{}
+	for _, tag := range tags {
+		tagByID[tag.ID] = tag
+	}
+
+	for _, tagging := range taggings {
+		if tag, ok := tagByID[tagging.TagID]; ok {
+			result.Tags[tagging.TaggedID] = append(result.Tags[tagging.TaggedID], tag)
+		}
+	}
+
+	for id := range result.Tags {
+		sort.Slice(result.Tags[id], func(i, j int) bool {
+			return result.Tags[id][i].Name < result.Tags[id][j].Name
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,53 @@
+package tag_service
+
+import (
+	"context"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type ListTagsParams struct {
+	NameLike   string
+	Pagination common_service.PaginationParams
+}
+
+type ListTagsResult struct {
+	Pagination common_service.PaginationResult
+	Tags       []tag_entity.Tag
+}
+
+func (s *TagServiceImpl) ListTags(ctx context.Context, params *ListTagsParams) (*ListTagsResult, error) {
+	filters := []tag_specification.TagSpecification{}
+
+	if exists.String(params.NameLike) {
+		filters = append(filters, tag_specification.NameLike(tag_entity.NormalizeName(params.NameLike)))
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	tags, err := s.tagRepository.List(ctx, common_repository.ListArgs[tag_specification.TagSpecification]{
+		Filters: filters,
+		Limit:   common_specification.WithLimit(params.Pagination.Limit()),
+		Offset:  common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		s.logger.Error("tag repository list error", "detail", err.Error())
+		return nil, err
+	}
+
+	size, err := s.tagRepository.Size(ctx, filters...)
+	if err != nil {
+		s.logger.Error("tag repository size error", "detail", err.Error())
+		return nil, err
+	}
+
+	return &ListTagsResult{
+		Pagination: common_service.NewPaginationResult(params.Pagination, size),
+		Tags:       tags,
+	}, nil
+}
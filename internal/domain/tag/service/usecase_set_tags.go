@@ -0,0 +1,62 @@
+package tag_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+	tag_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/types"
+)
+
+type SetTagsParams struct {
+	Target   tag_types.Target
+	TaggedID uuid.UUID
+	Names    []string
+}
+
+type SetTagsResult struct {
+	Tags tag_entity.Tags
+}
+
+// SetTags replaces the tags attached to the transaction or subscription.
+// Unknown names are created on the fly.
+func (s *TagServiceImpl) SetTags(ctx context.Context, params *SetTagsParams) (*SetTagsResult, error) {
+	taggingRepository := s.taggingRepositories[params.Target]
+	tags := tag_entity.Tags{}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		if err := taggingRepository.Delete(ctx, tag_specification.TaggedIs(params.TaggedID)); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, name := range tag_entity.NormalizeNames(params.Names) {
+			tag, err := s.findOrCreateTag(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			if err := taggingRepository.Save(ctx, tag_entity.Tagging{
+				ID:        uuid.New(),
+				TagID:     tag.ID,
+				TaggedID:  params.TaggedID,
+				CreatedAt: now,
+			}); err != nil {
+				return err
+			}
+
+			tags = append(tags, tag)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &SetTagsResult{
+		Tags: tags,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package tag_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/errors"
+	tag_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/specification"
+)
+
+type UpdateTagParams struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type UpdateTagResult struct {
+	Tag tag_entity.Tag
+}
+
+func (s *TagServiceImpl) UpdateTag(ctx context.Context, params *UpdateTagParams) (*UpdateTagResult, error) {
+	tag, err := s.tagRepository.Get(ctx, tag_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if tag == tag_entity.NoTag {
+		return nil, tag_errors.ErrTagNotFound
+	}
+
+	tag.Name = tag_entity.NormalizeName(params.Name)
+
+	if err := s.checkName(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	tag.UpdatedAt = time.Now()
+
+	if err := s.tagRepository.Save(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	return &UpdateTagResult{
+		Tag: tag,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package tag_specification
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+)
+
+type TagSpecification interface {
+	Call(tag tag_entity.Tag) bool
+}
+
+type WithIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithIDSpecification) Call(tag tag_entity.Tag) bool {
+	return spec.ID == tag.ID
+}
+
+func WithID(id uuid.UUID) TagSpecification {
+	return WithIDSpecification{
+		ID: id,
+	}
+}
+
+type WithoutIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithoutIDSpecification) Call(tag tag_entity.Tag) bool {
+	return spec.ID != tag.ID
+}
+
+func WithoutID(id uuid.UUID) TagSpecification {
+	return WithoutIDSpecification{
+		ID: id,
+	}
+}
+
+type IDInSpecification struct {
+	IDs []uuid.UUID
+}
+
+func (spec IDInSpecification) Call(tag tag_entity.Tag) bool {
+	return slices.Contains(spec.IDs, tag.ID)
+}
+
+func IDIn(ids []uuid.UUID) TagSpecification {
+	return IDInSpecification{
+		IDs: ids,
+	}
+}
+
+type NameIsSpecification struct {
+	Name string
+}
+
+func (spec NameIsSpecification) Call(tag tag_entity.Tag) bool {
+	return spec.Name == tag.Name
+}
+
+func NameIs(name string) TagSpecification {
+	return NameIsSpecification{
+		Name: name,
+	}
+}
+
+type NameLikeSpecification struct {
+	Substring string
+}
+
+func (spec NameLikeSpecification) Call(tag tag_entity.Tag) bool {
+	return strings.Contains(tag.Name, spec.Substring)
+}
+
+func NameLike(value string) TagSpecification {
+	return NameLikeSpecification{
+		Substring: value,
+	}
+}
+
+type TaggingSpecification interface {
+	Call(tagging tag_entity.Tagging) bool
+}
+
+type TaggedIsSpecification struct {
+	TaggedID uuid.UUID
+}
+
+func (spec TaggedIsSpecification) Call(tagging tag_entity.Tagging) bool {
+	return spec.TaggedID == tagging.TaggedID
+}
+
+func TaggedIs(taggedID uuid.UUID) TaggingSpecification {
+	return TaggedIsSpecification{
+		TaggedID: taggedID,
+	}
+}
+
+type TaggedInSpecification struct {
+	TaggedIDs []uuid.UUID
+}
+
+func (spec TaggedInSpecification) Call(tagging tag_entity.Tagging) bool {
+	return slices.Contains(spec.TaggedIDs, tagging.TaggedID)
+}
+
+func TaggedIn(taggedIDs []uuid.UUID) TaggingSpecification {
+	return TaggedInSpecification{
+		TaggedIDs: taggedIDs,
+	}
+}
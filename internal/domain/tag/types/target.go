@@ -0,0 +1,22 @@
+package tag_types
+
+// Target is the kind of record a tag is attached to.
+type Target int
+
+const (
+	TransactionTarget Target = iota
+	SubscriptionTarget
+)
+
+func (t Target) String() string {
+	switch t {
+	case TransactionTarget:
+		return "Transaction"
+	case SubscriptionTarget:
+		return "Subscription"
+	default:
+		return ""
+	}
+}
+
+var NoTarget Target = -1
@@ -2,12 +2,18 @@ package transaction_controller
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
 	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
 	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
 
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
 	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -17,6 +23,14 @@ type TransactionController interface {
 	Register(*echo.Echo)
 	ListTransactions(c echo.Context) error
 	GetTransaction(c echo.Context) error
+	CreateTransaction(c echo.Context) error
+	UpdateTransaction(c echo.Context) error
+	DeleteTransaction(c echo.Context) error
+	SummarizeTransactions(c echo.Context) error
+	CreateTransfer(c echo.Context) error
+	ListDuplicates(c echo.Context) error
+	ResolveDuplicates(c echo.Context) error
+	ReverseTransaction(c echo.Context) error
 }
 
 type TransactionControllerImpl struct {
@@ -24,8 +38,165 @@ type TransactionControllerImpl struct {
 }
 
 func (ctl *TransactionControllerImpl) Register(e *echo.Echo) {
+	e.POST("/v1/transactions", ctl.CreateTransaction)
+	e.PATCH("/v1/transactions/:id", ctl.UpdateTransaction)
+	e.DELETE("/v1/transactions/:id", ctl.DeleteTransaction)
+	e.POST("/v1/transactions/:id/reversals", ctl.ReverseTransaction)
+	e.GET("/v1/transactions/summary", ctl.SummarizeTransactions)
+	e.GET("/v1/transactions/duplicates", ctl.ListDuplicates)
+	e.POST("/v1/transactions/duplicates/resolve", ctl.ResolveDuplicates)
 	e.GET("/v1/transactions/:id", ctl.GetTransaction)
 	e.GET("/v1/transactions", ctl.ListTransactions)
+	e.POST("/v1/transfers", ctl.CreateTransfer)
+}
+
+func (ctl *TransactionControllerImpl) CreateTransaction(c echo.Context) error {
+	requestJSON := &CreateTransactionRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.transactionService.CreateTransaction(c.Request().Context(), &transaction_service.CreateTransactionParams{
+		AccountID:   requestJSON.Transaction.AccountID,
+		CategoryID:  requestJSON.Transaction.CategoryID,
+		PayeeID:     requestJSON.Transaction.PayeeID,
+		Description: requestJSON.Transaction.Description,
+		Amount:      requestJSON.Transaction.Amount,
+		Currency:    requestJSON.Transaction.Currency,
+		Direction:   transaction_types.GetDirection(requestJSON.Transaction.Direction),
+		CreatedAt:   requestJSON.Transaction.CreatedAt,
+		Splits:      newSplitParams(requestJSON.Transaction.Splits),
+		Tags:        requestJSON.Transaction.Tags,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &CreateTransactionResponse{
+		Transaction: NewTransactionResponse(result.Transaction, result.Payee, result.Splits, result.Tags),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (ctl *TransactionControllerImpl) UpdateTransaction(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &UpdateTransactionRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	params := &transaction_service.UpdateTransactionParams{
+		ID: id,
+	}
+
+	if requestJSON.Transaction.AccountID != nil {
+		params.AccountID = common_types.Maybe[uuid.UUID]{Present: true, Value: *requestJSON.Transaction.AccountID}
+	}
+
+	if requestJSON.Transaction.CategoryID != nil {
+		params.CategoryID = common_types.Maybe[uuid.UUID]{Present: true, Value: *requestJSON.Transaction.CategoryID}
+	}
+
+	if requestJSON.Transaction.PayeeID != nil {
+		params.PayeeID = common_types.Maybe[uuid.UUID]{Present: true, Value: *requestJSON.Transaction.PayeeID}
+	}
+
+	if requestJSON.Transaction.Description != nil {
+		params.Description = common_types.Maybe[string]{Present: true, Value: *requestJSON.Transaction.Description}
+	}
+
+	if requestJSON.Transaction.Amount != nil {
+		params.Amount = common_types.Maybe[int64]{Present: true, Value: *requestJSON.Transaction.Amount}
+	}
+
+	if requestJSON.Transaction.Direction != nil {
+		params.Direction = common_types.Maybe[transaction_types.Direction]{Present: true, Value: transaction_types.GetDirection(*requestJSON.Transaction.Direction)}
+	}
+
+	if requestJSON.Transaction.Label != nil {
+		params.Label = common_types.Maybe[string]{Present: true, Value: *requestJSON.Transaction.Label}
+	}
+
+	if requestJSON.Transaction.Ignored != nil {
+		params.Ignored = common_types.Maybe[bool]{Present: true, Value: *requestJSON.Transaction.Ignored}
+	}
+
+	if requestJSON.Transaction.CreatedAt != nil {
+		params.CreatedAt = common_types.Maybe[time.Time]{Present: true, Value: *requestJSON.Transaction.CreatedAt}
+	}
+
+	if requestJSON.Transaction.Splits != nil {
+		params.Splits = common_types.Maybe[[]transaction_service.SplitParams]{Present: true, Value: newSplitParams(*requestJSON.Transaction.Splits)}
+	}
+
+	if requestJSON.Transaction.Tags != nil {
+		params.Tags = common_types.Maybe[[]string]{Present: true, Value: *requestJSON.Transaction.Tags}
+	}
+
+	result, err := ctl.transactionService.UpdateTransaction(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &UpdateTransactionResponse{
+		Transaction: withReversals(NewTransactionResponse(result.Transaction, result.Payee, result.Splits, result.Tags), result.Transaction, result.Reversals),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *TransactionControllerImpl) DeleteTransaction(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	params := &transaction_service.DeleteTransactionParams{
+		ID: id,
+	}
+
+	if _, err := ctl.transactionService.DeleteTransaction(c.Request().Context(), params); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (ctl *TransactionControllerImpl) ReverseTransaction(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return common_errors.ErrInvalidUUID
+	}
+
+	requestJSON := &ReverseTransactionRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.transactionService.ReverseTransaction(c.Request().Context(), &transaction_service.ReverseTransactionParams{
+		ID:          id,
+		Amount:      requestJSON.Reversal.Amount,
+		Description: requestJSON.Reversal.Description,
+		CreatedAt:   requestJSON.Reversal.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &ReverseTransactionResponse{
+		Reversal:    NewTransactionResponse(result.Reversal, result.Payee, transaction_entity.NoSplits, tag_entity.NoTags),
+		Transaction: withReversals(NewTransactionResponse(result.Transaction, result.Payee, result.Splits, result.Tags), result.Transaction, result.Reversals),
+	}
+
+	return c.JSON(http.StatusCreated, response)
 }
 
 func (ctl *TransactionControllerImpl) GetTransaction(c echo.Context) error {
@@ -44,7 +215,7 @@ func (ctl *TransactionControllerImpl) GetTransaction(c echo.Context) error {
 	}
 
 	response := &GetTransactionResponse{
-		Transaction: NewTransactionResponse(result.Transaction),
+		Transaction: withReversals(NewTransactionResponse(result.Transaction, result.Payee, result.Splits, result.Tags), result.Transaction, result.Reversals),
 	}
 
 	return c.JSON(http.StatusOK, response)
@@ -52,11 +223,62 @@ func (ctl *TransactionControllerImpl) GetTransaction(c echo.Context) error {
 
 func (ctl *TransactionControllerImpl) ListTransactions(c echo.Context) error {
 	params := &transaction_service.ListTransactionsParams{
+		FilterTransactionsParams: transaction_service.FilterTransactionsParams{
+			DirectionIs: transaction_types.NoDirection,
+		},
 		Pagination: common_service.PaginationParams{},
 	}
 
 	if err := echo.QueryParamsBinder(c).
+		String("search", &params.Search).
 		String("description_like", &params.DescriptionLike).
+		CustomFunc("direction_is", func(values []string) []error {
+			params.DirectionIs = transaction_types.GetDirection(values[0])
+			return nil
+		}).
+		CustomFunc("account_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.AccountIs = id
+			return nil
+		}).
+		CustomFunc("category_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.CategoryIs = id
+			return nil
+		}).
+		CustomFunc("payee_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.PayeeIs = id
+			return nil
+		}).
+		String("currency", &params.CurrencyIs).
+		String("split_label", &params.SplitLabelIs).
+		CustomFunc("amount_from", bindAmount(&params.AmountFrom)).
+		CustomFunc("amount_to", bindAmount(&params.AmountTo)).
+		Time("created_from", &params.CreatedFrom, time.RFC3339).
+		Time("created_to", &params.CreatedTo, time.RFC3339).
+		Time("updated_from", &params.UpdatedFrom, time.RFC3339).
+		Time("updated_to", &params.UpdatedTo, time.RFC3339).
+		CustomFunc("subscription_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.ChargedBySubscription = id
+			return nil
+		}).
+		String("convert_to", &params.ConvertTo).
+		Strings("tags_any", &params.HasAnyTag).
+		Strings("tags_all", &params.HasAllTags).
 		Uint32("page", &params.Pagination.Page).
 		Uint32("page_size", &params.Pagination.PageSize).
 		FailFast(true).
@@ -72,12 +294,194 @@ func (ctl *TransactionControllerImpl) ListTransactions(c echo.Context) error {
 
 	response := &ListTransactionsResponse{
 		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
-		Transactions:       NewTransactionsResponse(result.Transactions),
+		Transactions:       NewTransactionsResponse(result.Transactions, result.Payees, result.Splits, result.Tags, result.Reversals, result.Converted, result.Matches),
 	}
 
 	return c.JSON(http.StatusOK, response)
 }
 
+func (ctl *TransactionControllerImpl) SummarizeTransactions(c echo.Context) error {
+	params := &transaction_service.SummarizeTransactionsParams{
+		FilterTransactionsParams: transaction_service.FilterTransactionsParams{
+			DirectionIs: transaction_types.NoDirection,
+		},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		String("search", &params.Search).
+		String("description_like", &params.DescriptionLike).
+		CustomFunc("direction_is", func(values []string) []error {
+			params.DirectionIs = transaction_types.GetDirection(values[0])
+			return nil
+		}).
+		CustomFunc("account_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.AccountIs = id
+			return nil
+		}).
+		CustomFunc("category_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.CategoryIs = id
+			return nil
+		}).
+		CustomFunc("payee_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.PayeeIs = id
+			return nil
+		}).
+		String("currency", &params.CurrencyIs).
+		String("split_label", &params.SplitLabelIs).
+		CustomFunc("amount_from", bindAmount(&params.AmountFrom)).
+		CustomFunc("amount_to", bindAmount(&params.AmountTo)).
+		Time("created_from", &params.CreatedFrom, time.RFC3339).
+		Time("created_to", &params.CreatedTo, time.RFC3339).
+		Time("updated_from", &params.UpdatedFrom, time.RFC3339).
+		Time("updated_to", &params.UpdatedTo, time.RFC3339).
+		CustomFunc("subscription_id", func(values []string) []error {
+			id, err := uuid.Parse(values[0])
+			if err != nil {
+				return []error{common_errors.ErrInvalidUUID}
+			}
+			params.ChargedBySubscription = id
+			return nil
+		}).
+		String("convert_to", &params.ConvertTo).
+		Strings("tags_any", &params.HasAnyTag).
+		Strings("tags_all", &params.HasAllTags).
+		FailFast(true).
+		BindError(); err != nil {
+		c.Logger().Error(err.Error())
+		return err
+	}
+
+	result, err := ctl.transactionService.SummarizeTransactions(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &SummarizeTransactionsResponse{
+		Income:   result.Income.Amount,
+		Expense:  result.Expense.Amount,
+		Net:      result.Net.Amount,
+		Currency: result.Net.Currency,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *TransactionControllerImpl) CreateTransfer(c echo.Context) error {
+	requestJSON := &CreateTransferRequest{}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.transactionService.CreateTransfer(c.Request().Context(), &transaction_service.CreateTransferParams{
+		SourceAccountName:      requestJSON.Transfer.Source,
+		DestinationAccountName: requestJSON.Transfer.Destination,
+		Description:            requestJSON.Transfer.Description,
+		Amount:                 requestJSON.Transfer.Amount,
+		Currency:               requestJSON.Transfer.Currency,
+		CreatedAt:              requestJSON.Transfer.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &CreateTransferResponse{
+		Transfer: NewTransferResponse(result.Transfer),
+	}
+
+	return c.JSON(http.StatusCreated, response)
+}
+
+func (ctl *TransactionControllerImpl) ListDuplicates(c echo.Context) error {
+	params := &transaction_service.ListDuplicatesParams{
+		Pagination: common_service.PaginationParams{},
+	}
+
+	if err := echo.QueryParamsBinder(c).
+		Uint32("page", &params.Pagination.Page).
+		Uint32("page_size", &params.Pagination.PageSize).
+		FailFast(true).
+		BindError(); err != nil {
+		return err
+	}
+
+	result, err := ctl.transactionService.ListDuplicates(c.Request().Context(), params)
+	if err != nil {
+		return err
+	}
+
+	response := &ListDuplicatesResponse{
+		PaginationResponse: common_schema.NewPaginationResponse(result.Pagination),
+		Duplicates:         NewDuplicatesResponse(result.Duplicates, result.Transactions, result.Payees),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (ctl *TransactionControllerImpl) ResolveDuplicates(c echo.Context) error {
+	requestJSON := &ResolveDuplicatesRequest{
+		Resolution: transaction_types.NoResolution,
+	}
+
+	if err := c.Bind(requestJSON); err != nil {
+		return common_errors.ErrBadRequest
+	}
+
+	result, err := ctl.transactionService.ResolveDuplicates(c.Request().Context(), &transaction_service.ResolveDuplicatesParams{
+		KeepID:         requestJSON.KeepID,
+		TransactionIDs: requestJSON.TransactionIDs,
+		Resolution:     requestJSON.Resolution,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &ResolveDuplicatesResponse{
+		Transaction:    NewTransactionResponse(result.Transaction, result.Payee, result.Splits, result.Tags),
+		TransactionIDs: result.TransactionIDs,
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// bindAmount binds an optional amount in minor units.
+func bindAmount(amount *common_types.Maybe[int64]) func(values []string) []error {
+	return func(values []string) []error {
+		value, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			return []error{common_errors.ErrBadRequest}
+		}
+
+		*amount = common_types.Maybe[int64]{Present: true, Value: value}
+		return nil
+	}
+}
+
+func newSplitParams(splits []SplitRequest) []transaction_service.SplitParams {
+	params := []transaction_service.SplitParams{}
+	for _, split := range splits {
+		params = append(params, transaction_service.SplitParams{
+			Amount: split.Amount,
+			Label:  split.Label,
+			Memo:   split.Memo,
+		})
+	}
+
+	return params
+}
+
 func New(transactionService transaction_service.TransactionService) TransactionController {
 	return &TransactionControllerImpl{
 		transactionService: transactionService,
@@ -4,22 +4,77 @@ import (
 	"time"
 
 	common_schema "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/schema"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
 	
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
 	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
 	
 	"github.com/google/uuid"
 )
 
 type TransactionResponse struct {
 	ID          uuid.UUID `json:"id"`
-	Description string    `json:"description"`
-	Amount      int32     `json:"amount"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	AccountID   uuid.UUID     `json:"account_id"`
+	TransferID  uuid.NullUUID `json:"transfer_id"`
+	CategoryID  uuid.NullUUID `json:"category_id"`
+	Payee       *PayeeResponse `json:"payee"`
+	ExternalID  string        `json:"external_id,omitempty"`
+	Description string        `json:"description"`
+	Amount      int64         `json:"amount"`
+	Currency    string        `json:"currency"`
+	Direction   string        `json:"direction"`
+	Label       string        `json:"label"`
+	Ignored     bool          `json:"ignored"`
+	Cleared     bool          `json:"cleared"`
+	ReconciliationID uuid.NullUUID `json:"reconciliation_id"`
+	ValueDate   *time.Time    `json:"value_date,omitempty"`
+	Splits      SplitsResponse `json:"splits"`
+	Tags        []string      `json:"tags"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+
+	// ConvertedAmount and ConvertedCurrency are only present when the amount
+	// was asked to be converted.
+	ConvertedAmount   *int64 `json:"converted_amount,omitempty"`
+	ConvertedCurrency string `json:"converted_currency,omitempty"`
+
+	// NetAmount is the amount left after the reversals in ReversalIDs. A
+	// reversal points to the transaction it reverses in ReversalOfID.
+	NetAmount    int64         `json:"net_amount"`
+	ReversalOfID uuid.NullUUID `json:"reversal_of_id"`
+	ReversalIDs  []uuid.UUID   `json:"reversal_ids"`
+
+	// Rank and Snippet are only present when searching. The snippet is the
+	// description with the matching words wrapped in <mark> tags.
+	Rank    *float64 `json:"rank,omitempty"`
+	Snippet string   `json:"snippet,omitempty"`
 }
 
 type TransactionsResponse []TransactionResponse
 
+type PayeeResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type SplitResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Amount   int64     `json:"amount"`
+	Currency string    `json:"currency"`
+	Label    string    `json:"label"`
+	Memo     string    `json:"memo"`
+}
+
+type SplitsResponse []SplitResponse
+
+type SplitRequest struct {
+	Amount int64  `json:"amount"`
+	Label  string `json:"label"`
+	Memo   string `json:"memo"`
+}
+
 type ListTransactionsResponse struct {
 	common_schema.PaginationResponse
 	Transactions TransactionsResponse `json:"transactions"`
@@ -29,22 +84,245 @@ type GetTransactionResponse struct {
 	Transaction TransactionResponse `json:"transaction"`
 }
 
-func NewTransactionResponse(transaction transaction_entity.Transaction) TransactionResponse {
+type TransactionRequest struct {
+	AccountID   uuid.UUID `json:"account_id"`
+	CategoryID  uuid.UUID `json:"category_id"`
+	PayeeID     uuid.UUID `json:"payee_id"`
+	Description string    `json:"description"`
+	Amount      int64     `json:"amount"`
+	Currency    string    `json:"currency"`
+	Direction   string    `json:"direction"`
+	CreatedAt   time.Time `json:"created_at"`
+	Splits      []SplitRequest `json:"splits"`
+	Tags        []string  `json:"tags"`
+}
+
+type CreateTransactionRequest struct {
+	Transaction TransactionRequest `json:"transaction"`
+}
+
+type CreateTransactionResponse struct {
+	Transaction TransactionResponse `json:"transaction"`
+}
+
+type UpdateTransactionFieldsRequest struct {
+	AccountID   *uuid.UUID `json:"account_id"`
+	CategoryID  *uuid.UUID `json:"category_id"`
+	PayeeID     *uuid.UUID `json:"payee_id"`
+	Description *string    `json:"description"`
+	Amount      *int64     `json:"amount"`
+	Direction   *string    `json:"direction"`
+	Label       *string    `json:"label"`
+	Ignored     *bool      `json:"ignored"`
+	CreatedAt   *time.Time `json:"created_at"`
+	Splits      *[]SplitRequest `json:"splits"`
+	Tags        *[]string  `json:"tags"`
+}
+
+type UpdateTransactionRequest struct {
+	Transaction UpdateTransactionFieldsRequest `json:"transaction"`
+}
+
+type UpdateTransactionResponse struct {
+	Transaction TransactionResponse `json:"transaction"`
+}
+
+func NewSplitsResponse(splits transaction_entity.Splits) SplitsResponse {
+	splitsResponse := SplitsResponse{}
+
+	for _, s := range splits {
+		splitsResponse = append(splitsResponse, SplitResponse{
+			ID:       s.ID,
+			Amount:   s.Amount.Amount,
+			Currency: s.Amount.Currency,
+			Label:    s.Label,
+			Memo:     s.Memo,
+		})
+	}
+
+	return splitsResponse
+}
+
+func NewPayeeResponse(payee payee_entity.Payee) *PayeeResponse {
+	if payee == payee_entity.NoPayee {
+		return nil
+	}
+
+	return &PayeeResponse{
+		ID:   payee.ID,
+		Name: payee.Name,
+	}
+}
+
+func NewTransactionResponse(transaction transaction_entity.Transaction, payee payee_entity.Payee, splits transaction_entity.Splits, tags tag_entity.Tags) TransactionResponse {
 	return TransactionResponse{
 		ID:          transaction.ID,
+		AccountID:   transaction.AccountID,
+		TransferID: uuid.NullUUID{
+			UUID:  transaction.TransferID,
+			Valid: transaction.IsTransfer(),
+		},
+		CategoryID: uuid.NullUUID{
+			UUID:  transaction.CategoryID,
+			Valid: transaction.IsCategorized(),
+		},
+		Payee:       NewPayeeResponse(payee),
+		ExternalID:  transaction.ExternalID,
 		Description: transaction.Description,
-		Amount:      transaction.Amount,
+		Amount:      transaction.Amount.Amount,
+		Currency:    transaction.Amount.Currency,
+		Direction:   transaction.Direction.String(),
+		Label:       transaction.EffectiveLabel(),
+		Ignored:     transaction.IsIgnored(),
+		Cleared:     transaction.Cleared,
+		ReconciliationID: uuid.NullUUID{
+			UUID:  transaction.ReconciliationID,
+			Valid: transaction.IsReconciled(),
+		},
+		ValueDate:   newValueDateResponse(transaction.ValueDate),
+		Splits:      NewSplitsResponse(splits),
+		Tags:        tags.Names(),
 		CreatedAt:   transaction.CreatedAt,
 		UpdatedAt:   transaction.UpdatedAt,
+		NetAmount:   transaction.Amount.Amount,
+		ReversalOfID: uuid.NullUUID{
+			UUID:  transaction.ReversalOfID,
+			Valid: transaction.IsReversal(),
+		},
+		ReversalIDs: []uuid.UUID{},
 	}
 }
 
-func NewTransactionsResponse(transactions transaction_entity.Transactions) TransactionsResponse {
+// withReversals fills in the net amount and the links to the reversals of the
+// transaction.
+func withReversals(response TransactionResponse, transaction transaction_entity.Transaction, reversals transaction_entity.Transactions) TransactionResponse {
+	response.NetAmount = transaction.NetAmount(reversals).Amount
+	for _, reversal := range reversals {
+		response.ReversalIDs = append(response.ReversalIDs, reversal.ID)
+	}
+
+	return response
+}
+
+func NewTransactionsResponse(transactions transaction_entity.Transactions, payees map[uuid.UUID]payee_entity.Payee, splits map[uuid.UUID]transaction_entity.Splits, tags map[uuid.UUID]tag_entity.Tags, reversals map[uuid.UUID]transaction_entity.Transactions, converted map[uuid.UUID]common_types.Money, matches map[uuid.UUID]transaction_entity.Match) TransactionsResponse {
 	transactionsResponse := TransactionsResponse{}
 
 	for _, s := range transactions {
-		transactionsResponse = append(transactionsResponse, NewTransactionResponse(s))
+		transactionResponse := withReversals(NewTransactionResponse(s, payees[s.PayeeID], splits[s.ID], tags[s.ID]), s, reversals[s.ID])
+		if amount, ok := converted[s.ID]; ok {
+			transactionResponse.ConvertedAmount = &amount.Amount
+			transactionResponse.ConvertedCurrency = amount.Currency
+		}
+
+		if match, ok := matches[s.ID]; ok {
+			transactionResponse.Rank = &match.Rank
+			transactionResponse.Snippet = match.Snippet
+		}
+
+		transactionsResponse = append(transactionsResponse, transactionResponse)
 	}
 
 	return transactionsResponse
 }
+
+type ReversalRequest struct {
+	Amount      int64     `json:"amount"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ReverseTransactionRequest struct {
+	Reversal ReversalRequest `json:"reversal"`
+}
+
+type ReverseTransactionResponse struct {
+	Reversal    TransactionResponse `json:"reversal"`
+	Transaction TransactionResponse `json:"transaction"`
+}
+
+type SummarizeTransactionsResponse struct {
+	Income   int64  `json:"income"`
+	Expense  int64  `json:"expense"`
+	Net      int64  `json:"net"`
+	Currency string `json:"currency"`
+}
+
+type TransferRequest struct {
+	Source      string    `json:"source"`
+	Destination string    `json:"destination"`
+	Description string    `json:"description"`
+	Amount      int64     `json:"amount"`
+	Currency    string    `json:"currency"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CreateTransferRequest struct {
+	Transfer TransferRequest `json:"transfer"`
+}
+
+type TransferResponse struct {
+	ID       uuid.UUID           `json:"id"`
+	Outgoing TransactionResponse `json:"outgoing"`
+	Incoming TransactionResponse `json:"incoming"`
+}
+
+type CreateTransferResponse struct {
+	Transfer TransferResponse `json:"transfer"`
+}
+
+func NewTransferResponse(transfer transaction_entity.Transfer) TransferResponse {
+	return TransferResponse{
+		ID:       transfer.ID,
+		Outgoing: NewTransactionResponse(transfer.Outgoing, payee_entity.NoPayee, transaction_entity.NoSplits, tag_entity.NoTags),
+		Incoming: NewTransactionResponse(transfer.Incoming, payee_entity.NoPayee, transaction_entity.NoSplits, tag_entity.NoTags),
+	}
+}
+
+type DuplicateResponse struct {
+	ID          uuid.UUID           `json:"id"`
+	Transaction TransactionResponse `json:"transaction"`
+	DuplicateOf TransactionResponse `json:"duplicate_of"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+type ListDuplicatesResponse struct {
+	common_schema.PaginationResponse
+	Duplicates []DuplicateResponse `json:"duplicates"`
+}
+
+type ResolveDuplicatesRequest struct {
+	KeepID         uuid.UUID                    `json:"keep_id"`
+	TransactionIDs []uuid.UUID                  `json:"transaction_ids"`
+	Resolution     transaction_types.Resolution `json:"resolution"`
+}
+
+type ResolveDuplicatesResponse struct {
+	Transaction    TransactionResponse `json:"transaction"`
+	TransactionIDs []uuid.UUID         `json:"transaction_ids"`
+}
+
+func NewDuplicatesResponse(duplicates transaction_entity.Duplicates, transactions map[uuid.UUID]transaction_entity.Transaction, payees map[uuid.UUID]payee_entity.Payee) []DuplicateResponse {
+	duplicatesResponse := []DuplicateResponse{}
+
+	for _, d := range duplicates {
+		transaction := transactions[d.TransactionID]
+		duplicateOf := transactions[d.DuplicateOfID]
+
+		duplicatesResponse = append(duplicatesResponse, DuplicateResponse{
+			ID:          d.ID,
+			Transaction: NewTransactionResponse(transaction, payees[transaction.PayeeID], transaction_entity.NoSplits, tag_entity.NoTags),
+			DuplicateOf: NewTransactionResponse(duplicateOf, payees[duplicateOf.PayeeID], transaction_entity.NoSplits, tag_entity.NoTags),
+			CreatedAt:   d.CreatedAt,
+		})
+	}
+
+	return duplicatesResponse
+}
+
+func newValueDateResponse(valueDate time.Time) *time.Time {
+	if valueDate.IsZero() {
+		return nil
+	}
+
+	return &valueDate
+}
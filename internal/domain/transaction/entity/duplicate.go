@@ -0,0 +1,95 @@
+package transaction_entity
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// DuplicateWindow is how far apart two transactions may be created and still
+// be considered the same one entered twice.
+const DuplicateWindow = 3 * 24 * time.Hour
+
+// Duplicate flags a transaction that looks like another one entered twice,
+// e.g. by importing a statement again. Dismissed flags were reviewed and
+// found to be distinct transactions, they are never raised again.
+type Duplicate struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	DuplicateOfID uuid.UUID
+	Dismissed     bool
+	CreatedAt     time.Time
+}
+
+type Duplicates []Duplicate
+
+var NoDuplicate = Duplicate{}
+var NoDuplicates = []Duplicate{}
+
+// Other returns the transaction flagged together with the given one.
+func (d Duplicate) Other(transactionID uuid.UUID) uuid.UUID {
+	if d.TransactionID == transactionID {
+		return d.DuplicateOfID
+	}
+
+	return d.TransactionID
+}
+
+// NormalizeDescription keeps only the words of the description, so reference
+// numbers and punctuation banks append to each entry do not matter.
+func NormalizeDescription(description string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}), " ")
+}
+
+// IsDuplicateOf reports whether both transactions move the same amount on the
+// same account within the duplicate window, with descriptions sharing at
+// least half of their words.
+func (t Transaction) IsDuplicateOf(other Transaction) bool {
+	if t.ID == other.ID || t.AccountID != other.AccountID || t.Amount != other.Amount || t.Direction != other.Direction {
+		return false
+	}
+
+	if t.IsTransfer() || other.IsTransfer() {
+		return false
+	}
+
+	gap := t.CreatedAt.Sub(other.CreatedAt)
+	if gap < -DuplicateWindow || gap > DuplicateWindow {
+		return false
+	}
+
+	return similarity(NormalizeDescription(t.Description), NormalizeDescription(other.Description)) >= 0.5
+}
+
+// similarity is the Jaccard index of the words of both descriptions.
+func similarity(a string, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	words := map[string]int{}
+	for _, word := range strings.Fields(a) {
+		words[word] |= 1
+	}
+
+	for _, word := range strings.Fields(b) {
+		words[word] |= 2
+	}
+
+	shared := 0
+	for _, in := range words {
+		if in == 3 {
+			shared++
+		}
+	}
+
+	if len(words) == 0 {
+		return 0
+	}
+
+	return float64(shared) / float64(len(words))
+}
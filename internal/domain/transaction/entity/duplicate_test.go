@@ -0,0 +1,96 @@
+package transaction_entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+func TestNormalizeDescription(t *testing.T) {
+	tests := []struct {
+		description string
+		want        string
+	}{
+		{description: "GRAB*FOOD 12345 Jakarta", want: "grab food jakarta"},
+		{description: "  Coffee,   shop!  ", want: "coffee shop"},
+		{description: "#0042", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := NormalizeDescription(tt.description); got != tt.want {
+				t.Errorf("NormalizeDescription(%q) = %q, want %q", tt.description, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransactionIsDuplicateOf(t *testing.T) {
+	accountID := uuid.New()
+	createdAt := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	original := Transaction{
+		ID:          uuid.New(),
+		AccountID:   accountID,
+		Description: "GRAB*FOOD 12345 Jakarta",
+		Amount:      common_types.NewMoney(4500000, "IDR"),
+		Direction:   transaction_types.Expense,
+		CreatedAt:   createdAt,
+	}
+
+	duplicate := func(change func(*Transaction)) Transaction {
+		other := original
+		other.ID = uuid.New()
+		change(&other)
+		return other
+	}
+
+	tests := []struct {
+		name  string
+		other Transaction
+		want  bool
+	}{
+		{name: "same transaction entered twice", other: duplicate(func(o *Transaction) {}), want: true},
+		{name: "different reference number", other: duplicate(func(o *Transaction) { o.Description = "GRAB FOOD 67890 JAKARTA" }), want: true},
+		{name: "half of the words shared", other: duplicate(func(o *Transaction) { o.Description = "Grab food" }), want: true},
+		{name: "within the window", other: duplicate(func(o *Transaction) { o.CreatedAt = createdAt.Add(-DuplicateWindow) }), want: true},
+		{name: "itself", other: original, want: false},
+		{name: "outside the window", other: duplicate(func(o *Transaction) { o.CreatedAt = createdAt.Add(DuplicateWindow + time.Second) }), want: false},
+		{name: "another account", other: duplicate(func(o *Transaction) { o.AccountID = uuid.New() }), want: false},
+		{name: "another amount", other: duplicate(func(o *Transaction) { o.Amount = common_types.NewMoney(4500001, "IDR") }), want: false},
+		{name: "another currency", other: duplicate(func(o *Transaction) { o.Amount = common_types.NewMoney(4500000, "USD") }), want: false},
+		{name: "another direction", other: duplicate(func(o *Transaction) { o.Direction = transaction_types.Income }), want: false},
+		{name: "transfer", other: duplicate(func(o *Transaction) { o.TransferID = uuid.New() }), want: false},
+		{name: "unrelated description", other: duplicate(func(o *Transaction) { o.Description = "Gojek ride Bandung" }), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := original.IsDuplicateOf(tt.other); got != tt.want {
+				t.Errorf("IsDuplicateOf() = %v, want %v", got, tt.want)
+			}
+
+			if got := tt.other.IsDuplicateOf(original); got != tt.want {
+				t.Errorf("IsDuplicateOf() the other way = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicateOther(t *testing.T) {
+	duplicate := Duplicate{
+		TransactionID: uuid.New(),
+		DuplicateOfID: uuid.New(),
+	}
+
+	if got := duplicate.Other(duplicate.TransactionID); got != duplicate.DuplicateOfID {
+		t.Errorf("Other(TransactionID) = %v, want %v", got, duplicate.DuplicateOfID)
+	}
+
+	if got := duplicate.Other(duplicate.DuplicateOfID); got != duplicate.TransactionID {
+		t.Errorf("Other(DuplicateOfID) = %v, want %v", got, duplicate.TransactionID)
+	}
+}
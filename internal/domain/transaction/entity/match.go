@@ -0,0 +1,11 @@
+package transaction_entity
+
+// Match is a transaction found by a full-text search, with how relevant it is
+// to the query and the description with the matching words highlighted.
+type Match struct {
+	Transaction Transaction
+	Rank        float64
+	Snippet     string
+}
+
+type Matches []Match
@@ -0,0 +1,53 @@
+package transaction_entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+)
+
+// Split is one line of a transaction, e.g. the household part of a supermarket
+// receipt. The amounts of every split of a transaction add up to its amount.
+type Split struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	Amount        common_types.Money
+	Label         string
+	Memo          string
+	CreatedAt     time.Time
+}
+
+type Splits []Split
+
+var NoSplit = Split{}
+var NoSplits = []Split{}
+
+func (s Splits) Sum() (common_types.Money, error) {
+	sum := common_types.NoMoney
+	for _, split := range s {
+		var err error
+		sum, err = sum.Add(split.Amount)
+		if err != nil {
+			return common_types.NoMoney, err
+		}
+	}
+
+	return sum, nil
+}
+
+// IsBalanced reports whether the splits add up to the magnitude of the
+// transaction amount. A transaction without splits is balanced.
+func (s Splits) IsBalanced(transaction Transaction) bool {
+	if len(s) == 0 {
+		return true
+	}
+
+	sum, err := s.Sum()
+	if err != nil {
+		return false
+	}
+
+	return sum == transaction.Amount.Abs()
+}
@@ -0,0 +1,63 @@
+package transaction_entity
+
+import (
+	"errors"
+	"testing"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+)
+
+func TestSplitsSum(t *testing.T) {
+	tests := []struct {
+		name    string
+		splits  Splits
+		want    common_types.Money
+		wantErr error
+	}{
+		{name: "no splits", splits: Splits{}, want: common_types.NoMoney},
+		{name: "one split", splits: Splits{{Amount: common_types.NewMoney(1000, "IDR")}}, want: common_types.NewMoney(1000, "IDR")},
+		{name: "several splits", splits: Splits{{Amount: common_types.NewMoney(1000, "IDR")}, {Amount: common_types.NewMoney(2500, "IDR")}}, want: common_types.NewMoney(3500, "IDR")},
+		{name: "mixed currencies", splits: Splits{{Amount: common_types.NewMoney(1000, "IDR")}, {Amount: common_types.NewMoney(2500, "USD")}}, wantErr: common_errors.ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.splits.Sum()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Sum() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Sum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitsIsBalanced(t *testing.T) {
+	transaction := Transaction{Amount: common_types.NewMoney(5000, "IDR")}
+
+	tests := []struct {
+		name        string
+		splits      Splits
+		transaction Transaction
+		want        bool
+	}{
+		{name: "no splits", splits: Splits{}, transaction: transaction, want: true},
+		{name: "adds up to the amount", splits: Splits{{Amount: common_types.NewMoney(2000, "IDR")}, {Amount: common_types.NewMoney(3000, "IDR")}}, transaction: transaction, want: true},
+		{name: "adds up to a negative amount", splits: Splits{{Amount: common_types.NewMoney(5000, "IDR")}}, transaction: Transaction{Amount: common_types.NewMoney(-5000, "IDR")}, want: true},
+		{name: "falls short", splits: Splits{{Amount: common_types.NewMoney(2000, "IDR")}}, transaction: transaction, want: false},
+		{name: "goes over", splits: Splits{{Amount: common_types.NewMoney(2000, "IDR")}, {Amount: common_types.NewMoney(3001, "IDR")}}, transaction: transaction, want: false},
+		{name: "another currency", splits: Splits{{Amount: common_types.NewMoney(5000, "USD")}}, transaction: transaction, want: false},
+		{name: "mixed currencies", splits: Splits{{Amount: common_types.NewMoney(2500, "IDR")}, {Amount: common_types.NewMoney(2500, "USD")}}, transaction: transaction, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.splits.IsBalanced(tt.transaction); got != tt.want {
+				t.Errorf("IsBalanced() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
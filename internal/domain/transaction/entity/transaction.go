@@ -4,17 +4,123 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
 )
 
 type Transaction struct {
-	ID          uuid.UUID
-	Description string
-	Amount      int32
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	TransferID uuid.UUID
+	CategoryID uuid.UUID
+	// PayeeID is resolved from the payee aliases when the transaction is
+	// created, unless one is given explicitly.
+	PayeeID uuid.UUID
+	// JournalEntryID points to the ledger entry this transaction is projected
+	// from. Both legs of a transfer share one entry.
+	JournalEntryID uuid.UUID
+	// ExternalID is the id the bank gave the transaction in an imported
+	// statement, e.g. the OFX FITID, so it is never imported twice.
+	ExternalID string
+	// SubscriptionID points to the subscription whose fee the transaction
+	// charges.
+	SubscriptionID uuid.UUID
+	// ReversalOfID points to the transaction this one refunds or returns,
+	// wholly or in part, in the opposite direction.
+	ReversalOfID uuid.UUID
+	Description  string
+	Amount       common_types.Money
+	Direction    transaction_types.Direction
+	// Label and Ignored are set by the user. RuleLabel and RuleIgnored are
+	// worked out from scratch by the rules whenever the transaction is saved,
+	// so they follow the rules as they change. Ignored transactions are left
+	// out of the totals.
+	Label       string
+	Ignored     bool
+	RuleLabel   string
+	RuleIgnored bool
+	// Cleared transactions showed up on a bank statement. Once the statement
+	// is reconciled the transaction points to the reconciliation and is locked.
+	Cleared          bool
+	ReconciliationID uuid.UUID
+	// ValueDate is when the amount took effect on the balance according to
+	// the bank, which may differ from the booking date in CreatedAt. It is
+	// only known for imported statements.
+	ValueDate time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type Transactions []Transaction
 
 var NoTransactions = []Transaction{}
 var NoTransaction = Transaction{}
+
+type Transfer struct {
+	ID       uuid.UUID
+	Outgoing Transaction
+	Incoming Transaction
+}
+
+func (t Transaction) IsTransfer() bool {
+	return t.TransferID != uuid.Nil
+}
+
+func (t Transaction) IsCharge() bool {
+	return t.SubscriptionID != uuid.Nil
+}
+
+func (t Transaction) IsReversal() bool {
+	return t.ReversalOfID != uuid.Nil
+}
+
+func (t Transaction) IsCategorized() bool {
+	return t.CategoryID != uuid.Nil
+}
+
+func (t Transaction) HasPayee() bool {
+	return t.PayeeID != uuid.Nil
+}
+
+// EffectiveLabel is the label set by the user, else the one set by the rules.
+func (t Transaction) EffectiveLabel() string {
+	if t.Label != "" {
+		return t.Label
+	}
+
+	return t.RuleLabel
+}
+
+// IsIgnored reports whether the user or any rule ignored the transaction.
+func (t Transaction) IsIgnored() bool {
+	return t.Ignored || t.RuleIgnored
+}
+
+func (t Transaction) IsReconciled() bool {
+	return t.ReconciliationID != uuid.Nil
+}
+
+// SignedAmount returns the amount as it affects the holder: positive for income,
+// negative for expense. Transfer legs already carry their sign: the outgoing leg
+// is stored negative and the incoming leg positive.
+func (t Transaction) SignedAmount() common_types.Money {
+	switch t.Direction {
+	case transaction_types.Income:
+		return t.Amount
+	case transaction_types.Expense:
+		return t.Amount.Neg()
+	default:
+		return t.Amount
+	}
+}
+
+// NetAmount returns the amount left after the reversals of the transaction.
+func (t Transaction) NetAmount(reversals Transactions) common_types.Money {
+	net := t.Amount
+	for _, reversal := range reversals {
+		net.Amount -= reversal.Amount.Amount
+	}
+
+	return net
+}
@@ -0,0 +1,55 @@
+package transaction_entity
+
+import (
+	"testing"
+
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+func TestTransactionSignedAmount(t *testing.T) {
+	tests := []struct {
+		name        string
+		transaction Transaction
+		want        common_types.Money
+	}{
+		{name: "income", transaction: Transaction{Amount: common_types.NewMoney(1000, "IDR"), Direction: transaction_types.Income}, want: common_types.NewMoney(1000, "IDR")},
+		{name: "expense", transaction: Transaction{Amount: common_types.NewMoney(1000, "IDR"), Direction: transaction_types.Expense}, want: common_types.NewMoney(-1000, "IDR")},
+		{name: "outgoing transfer leg", transaction: Transaction{Amount: common_types.NewMoney(-1000, "IDR"), Direction: transaction_types.Transfer}, want: common_types.NewMoney(-1000, "IDR")},
+		{name: "incoming transfer leg", transaction: Transaction{Amount: common_types.NewMoney(1000, "IDR"), Direction: transaction_types.Transfer}, want: common_types.NewMoney(1000, "IDR")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.transaction.SignedAmount(); got != tt.want {
+				t.Errorf("SignedAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransactionNetAmount(t *testing.T) {
+	transaction := Transaction{Amount: common_types.NewMoney(10000, "IDR"), Direction: transaction_types.Expense}
+	reversal := func(amount int64) Transaction {
+		return Transaction{Amount: common_types.NewMoney(amount, "IDR"), Direction: transaction_types.Income}
+	}
+
+	tests := []struct {
+		name      string
+		reversals Transactions
+		want      common_types.Money
+	}{
+		{name: "no reversals", reversals: Transactions{}, want: common_types.NewMoney(10000, "IDR")},
+		{name: "partial refund", reversals: Transactions{reversal(2500)}, want: common_types.NewMoney(7500, "IDR")},
+		{name: "several refunds", reversals: Transactions{reversal(2500), reversal(7500)}, want: common_types.NewMoney(0, "IDR")},
+		{name: "refunded too much", reversals: Transactions{reversal(6000), reversal(6000)}, want: common_types.NewMoney(-2000, "IDR")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transaction.NetAmount(tt.reversals); got != tt.want {
+				t.Errorf("NetAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -12,4 +12,106 @@ var (
 		Reason:  "TRANSACTION_NOT_FOUND_ERROR",
 		Message: "Transaction not found. Please pass valid transaction id.",
 	}
+
+	ErrTransactionDescriptionEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_DESCRIPTION_EMPTY_ERROR",
+		Message: "Transaction description is empty. Please pass non-empty description.",
+	}
+
+	ErrTransactionAmountInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_AMOUNT_INVALID_ERROR",
+		Message: "Transaction amount is not valid. Please pass amount greater than zero.",
+	}
+
+	ErrTransactionDirectionInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_DIRECTION_INVALID_ERROR",
+		Message: "Transaction direction is not valid. Please choose valid transaction direction.",
+	}
+
+	ErrTransactionTransferDirection = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_TRANSFER_DIRECTION_ERROR",
+		Message: "Transfer direction is reserved for transfers. Please use transfer endpoint to move money between accounts.",
+	}
+
+	ErrTransactionTransferPayee = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_TRANSFER_PAYEE_ERROR",
+		Message: "Transfers move money between your own accounts and have no payee.",
+	}
+
+	ErrTransferSameAccount = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSFER_SAME_ACCOUNT_ERROR",
+		Message: "Source and destination account are the same. Please pass different accounts.",
+	}
+
+	ErrTransactionSplitsUnbalanced = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_SPLITS_UNBALANCED_ERROR",
+		Message: "Transaction splits do not add up to the transaction amount. Please adjust the split amounts.",
+	}
+
+	ErrTransactionSplitLabelEmpty = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_SPLIT_LABEL_EMPTY_ERROR",
+		Message: "Transaction split label is empty. Please pass non-empty label for every split.",
+	}
+
+	ErrTransactionSplitAmountInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_SPLIT_AMOUNT_INVALID_ERROR",
+		Message: "Transaction split amount is not valid. Please pass amount greater than zero.",
+	}
+
+	ErrTransactionSplitTransfer = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_SPLIT_TRANSFER_ERROR",
+		Message: "Transfers cannot be split. Please split income or expense transactions only.",
+	}
+
+	ErrTransactionReconciled = &common_errors.Error{
+		Code:    http.StatusConflict,
+		Reason:  "TRANSACTION_RECONCILED_ERROR",
+		Message: "Transaction is reconciled and locked. Please reopen its reconciliation first.",
+	}
+
+	ErrTransactionReversalTransfer = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_REVERSAL_TRANSFER_ERROR",
+		Message: "Transfers cannot be reversed. Please create a transfer in the opposite direction instead.",
+	}
+
+	ErrTransactionReversalOfReversal = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_REVERSAL_OF_REVERSAL_ERROR",
+		Message: "Transaction is a reversal itself. Please delete the reversal instead of reversing it.",
+	}
+
+	ErrTransactionReversalExceeded = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "TRANSACTION_REVERSAL_EXCEEDED_ERROR",
+		Template: "Reversals exceed the transaction amount by %s. Please adjust the amount.",
+	}
+
+	ErrTransactionReversed = &common_errors.Error{
+		Code:    http.StatusConflict,
+		Reason:  "TRANSACTION_REVERSED_ERROR",
+		Message: "Transaction is linked to a reversal. Please delete the reversal first.",
+	}
+
+	ErrTransactionNotDuplicate = &common_errors.DynamicError{
+		Code:     http.StatusUnprocessableEntity,
+		Reason:   "TRANSACTION_NOT_DUPLICATE_ERROR",
+		Template: "Transaction %s is not flagged as duplicate of the kept transaction. Please pass flagged transactions only.",
+	}
+
+	ErrTransactionResolutionInvalid = &common_errors.Error{
+		Code:    http.StatusUnprocessableEntity,
+		Reason:  "TRANSACTION_RESOLUTION_INVALID_ERROR",
+		Message: "Duplicate resolution is not valid. Please choose Delete or Dismiss.",
+	}
 )
@@ -1,6 +1,8 @@
 package transaction_repository
 
 import (
+	"context"
+
 	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
 
 	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
@@ -8,3 +10,13 @@ import (
 )
 
 type TransactionRepository common_repository.Repository[transaction_entity.Transaction, transaction_specification.TransactionSpecification]
+
+type SplitRepository common_repository.Repository[transaction_entity.Split, transaction_specification.SplitSpecification]
+
+type DuplicateRepository common_repository.Repository[transaction_entity.Duplicate, transaction_specification.DuplicateSpecification]
+
+// SearchRepository lists the transactions matching a full-text search, most
+// relevant first.
+type SearchRepository interface {
+	Search(ctx context.Context, query string, args common_repository.ListArgs[transaction_specification.TransactionSpecification]) (transaction_entity.Matches, error)
+}
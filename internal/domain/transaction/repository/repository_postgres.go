@@ -2,34 +2,214 @@ package transaction_repository
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
 
 	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
 	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
 
+	category_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/repository"
+	tag_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/repository"
 	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
 	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
 
 	"github.com/google/uuid"
 )
 
+// PostgresTransactionLocked marks reconciled transactions read-only.
+const PostgresTransactionLocked = "transactions.reconciliation_id IS NOT NULL"
+
 var Columns []string = []string{
 	"id",
+	"account_id",
+	"transfer_id",
+	"category_id",
+	"payee_id",
+	"journal_entry_id",
+	"external_id",
+	"subscription_id",
+	"reversal_of_id",
 	"description",
 	"amount",
+	"currency",
+	"direction",
+	"label",
+	"ignored",
+	"rule_label",
+	"rule_ignored",
+	"cleared",
+	"reconciliation_id",
+	"value_date",
 	"created_at",
 	"updated_at",
 }
 
 type PostgresTransactionRow struct {
-	ID          uuid.UUID
-	Description string
-	Amount      int32
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID               uuid.UUID
+	AccountID        uuid.UUID
+	TransferID       uuid.NullUUID
+	CategoryID       uuid.NullUUID
+	PayeeID          uuid.NullUUID
+	JournalEntryID   uuid.UUID
+	ExternalID       sql.NullString
+	SubscriptionID   uuid.NullUUID
+	ReversalOfID     uuid.NullUUID
+	Description      string
+	Amount           int64
+	Currency         string
+	Direction        string
+	Label            sql.NullString
+	Ignored          bool
+	RuleLabel        sql.NullString
+	RuleIgnored      bool
+	Cleared          bool
+	ReconciliationID uuid.NullUUID
+	ValueDate        sql.NullTime
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// dest lists the fields to scan the columns into, in the order of Columns.
+func (row *PostgresTransactionRow) dest() []any {
+	return []any{&row.ID, &row.AccountID, &row.TransferID, &row.CategoryID, &row.PayeeID, &row.JournalEntryID, &row.ExternalID, &row.SubscriptionID, &row.ReversalOfID, &row.Description, &row.Amount, &row.Currency, &row.Direction, &row.Label, &row.Ignored, &row.RuleLabel, &row.RuleIgnored, &row.Cleared, &row.ReconciliationID, &row.ValueDate, &row.CreatedAt, &row.UpdatedAt}
+}
+
+func newPostgresTransaction(row *PostgresTransactionRow) transaction_entity.Transaction {
+	return transaction_entity.Transaction{
+		ID:               row.ID,
+		AccountID:        row.AccountID,
+		TransferID:       row.TransferID.UUID,
+		CategoryID:       row.CategoryID.UUID,
+		PayeeID:          row.PayeeID.UUID,
+		JournalEntryID:   row.JournalEntryID,
+		ExternalID:       row.ExternalID.String,
+		SubscriptionID:   row.SubscriptionID.UUID,
+		ReversalOfID:     row.ReversalOfID.UUID,
+		Description:      row.Description,
+		Amount:           common_types.NewMoney(row.Amount, row.Currency),
+		Direction:        transaction_types.GetDirection(row.Direction),
+		Label:            row.Label.String,
+		Ignored:          row.Ignored,
+		RuleLabel:        row.RuleLabel.String,
+		RuleIgnored:      row.RuleIgnored,
+		Cleared:          row.Cleared,
+		ReconciliationID: row.ReconciliationID.UUID,
+		ValueDate:        row.ValueDate.Time,
+		CreatedAt:        row.CreatedAt,
+		UpdatedAt:        row.UpdatedAt,
+	}
+}
+
+// PostgresTransactionFilter translates the transaction specifications into a
+// condition over the transactions table.
+func PostgresTransactionFilter(specs ...transaction_specification.TransactionSpecification) squirrel.Sqlizer {
+	where := squirrel.And{}
+	for _, spec := range specs {
+		switch v := spec.(type) {
+		case transaction_specification.WithIDSpecification:
+			where = append(where, squirrel.Eq{"id": v.ID})
+		case transaction_specification.DescriptionLikeSpecification:
+			where = append(where, squirrel.ILike{"description": "%" + postgresLikeEscaper.Replace(v.Like) + "%"})
+		case transaction_specification.WithoutIDSpecification:
+			where = append(where, squirrel.NotEq{"id": v.ID})
+		case transaction_specification.IDInSpecification:
+			where = append(where, squirrel.Eq{"id": v.IDs})
+		case transaction_specification.TransferIsSpecification:
+			where = append(where, squirrel.Eq{"transfer_id": v.TransferID})
+		case transaction_specification.DirectionIsSpecification:
+			where = append(where, squirrel.Eq{"direction": v.Direction.String()})
+		case transaction_specification.AccountIsSpecification:
+			where = append(where, squirrel.Eq{"account_id": v.AccountID})
+		case transaction_specification.CategoryIsSpecification:
+			where = append(where, category_repository.PostgresCategoryIn("category_id", v.CategoryID))
+		case transaction_specification.PayeeIsSpecification:
+			where = append(where, squirrel.Eq{"payee_id": v.PayeeID})
+		case transaction_specification.HasAnyTagSpecification:
+			where = append(where, tag_repository.PostgresHasAnyTag(tag_repository.PostgresTransactionTagsTable, tag_repository.PostgresTransactionTagsColumn, v.Names))
+		case transaction_specification.HasAllTagsSpecification:
+			where = append(where, tag_repository.PostgresHasAllTags(tag_repository.PostgresTransactionTagsTable, tag_repository.PostgresTransactionTagsColumn, v.Names))
+		case transaction_specification.SplitLabelIsSpecification:
+			where = append(where, PostgresSplitLabelIs(v.Label))
+		case transaction_specification.CurrencyIsSpecification:
+			where = append(where, squirrel.Eq{"currency": v.Currency})
+		case transaction_specification.CreatedBeforeSpecification:
+			where = append(where, squirrel.LtOrEq{"created_at": v.Time})
+		case transaction_specification.CreatedAfterSpecification:
+			where = append(where, squirrel.GtOrEq{"created_at": v.Time})
+		case transaction_specification.CreatedBetweenSpecification:
+			where = append(where, postgresBetween("created_at", v.Start, v.End))
+		case transaction_specification.UpdatedBetweenSpecification:
+			where = append(where, postgresBetween("updated_at", v.Start, v.End))
+		case transaction_specification.AmountBetweenSpecification:
+			if v.Min.Present {
+				where = append(where, squirrel.Expr("ABS(amount) >= ?", v.Min.Value))
+			}
+
+			if v.Max.Present {
+				where = append(where, squirrel.Expr("ABS(amount) <= ?", v.Max.Value))
+			}
+		case transaction_specification.ChargedBySubscriptionSpecification:
+			where = append(where, squirrel.Eq{"subscription_id": v.SubscriptionIDs})
+		case transaction_specification.ReversalOfSpecification:
+			where = append(where, squirrel.Eq{"reversal_of_id": v.TransactionIDs})
+		case transaction_specification.SearchSpecification:
+			where = append(where, PostgresSearch(v.Query))
+		case transaction_specification.ExternalIDInSpecification:
+			where = append(where, squirrel.Eq{"external_id": v.ExternalIDs})
+		case transaction_specification.ClearedIsSpecification:
+			where = append(where, squirrel.Eq{"cleared": v.Cleared})
+		case transaction_specification.ReconciledIsSpecification:
+			if v.Reconciled {
+				where = append(where, squirrel.NotEq{"reconciliation_id": nil})
+			} else {
+				where = append(where, squirrel.Eq{"reconciliation_id": nil})
+			}
+		case transaction_specification.ReversalIsSpecification:
+			if v.Reversal {
+				where = append(where, squirrel.NotEq{"reversal_of_id": nil})
+			} else {
+				where = append(where, squirrel.Eq{"reversal_of_id": nil})
+			}
+		case transaction_specification.ReconciliationIsSpecification:
+			where = append(where, squirrel.Eq{"reconciliation_id": v.ReconciliationID})
+		case transaction_specification.AmountIsSpecification:
+			where = append(where, squirrel.Eq{"amount": v.Amount.Amount, "currency": v.Amount.Currency})
+		}
+	}
+
+	return where
+}
+
+// postgresLikeEscaper escapes the wildcards of a LIKE pattern, so a
+// description like "100%" is matched literally.
+var postgresLikeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// postgresBetween matches the column within the bounds, both included,
+// leaving a side with a zero bound open.
+func postgresBetween(column string, start time.Time, end time.Time) squirrel.Sqlizer {
+	where := squirrel.And{}
+	if !start.IsZero() {
+		where = append(where, squirrel.GtOrEq{column: start})
+	}
+
+	if !end.IsZero() {
+		where = append(where, squirrel.LtOrEq{column: end})
+	}
+
+	return where
+}
+
+// PostgresSearch matches the search vector, built from the description and
+// label, against the query in web search syntax. The simple configuration
+// leaves words unstemmed, as descriptions mix languages.
+func PostgresSearch(query string) squirrel.Sqlizer {
+	return squirrel.Expr("search_vector @@ websearch_to_tsquery('simple', ?)", query)
 }
 
 func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (TransactionRepository, error) {
@@ -37,54 +217,121 @@ func NewPostgresRepository(logger logger.Logger, dbm database_manager.DatabaseMa
 		Logger:    logger,
 		TableName: "transactions",
 		Schema: map[string]string{
-			"id":          postgres_repository.UUID,
-			"description": postgres_repository.CharacterVarying,
-			"amount":      postgres_repository.Integer,
-			"created_at":  postgres_repository.TimestampWithZone,
-			"updated_at":  postgres_repository.TimestampWithZone,
-		},
-		Columns: []string{
-			"id",
-			"description",
-			"amount",
-			"created_at",
-			"updated_at",
+			"id":                postgres_repository.UUID,
+			"account_id":        postgres_repository.UUID,
+			"transfer_id":       postgres_repository.UUID,
+			"category_id":       postgres_repository.UUID,
+			"payee_id":          postgres_repository.UUID,
+			"journal_entry_id":  postgres_repository.UUID,
+			"external_id":       postgres_repository.CharacterVarying,
+			"subscription_id":   postgres_repository.UUID,
+			"reversal_of_id":    postgres_repository.UUID,
+			"description":       postgres_repository.CharacterVarying,
+			"amount":            postgres_repository.BigInt,
+			"currency":          postgres_repository.Character,
+			"direction":         postgres_repository.CharacterVarying,
+			"label":             postgres_repository.CharacterVarying,
+			"ignored":           postgres_repository.Boolean,
+			"rule_label":        postgres_repository.CharacterVarying,
+			"rule_ignored":      postgres_repository.Boolean,
+			"cleared":           postgres_repository.Boolean,
+			"reconciliation_id": postgres_repository.UUID,
+			"value_date":        postgres_repository.Date,
+			"search_vector":     postgres_repository.TSVector,
+			"created_at":        postgres_repository.TimestampWithZone,
+			"updated_at":        postgres_repository.TimestampWithZone,
 		},
+		Columns:         Columns,
 		PrimaryKey:      "id",
 		DatabaseManager: dbm,
-		Filter: func(specs ...transaction_specification.TransactionSpecification) squirrel.Sqlizer {
-			return squirrel.And{}
-		},
+		Locked:          PostgresTransactionLocked,
+		Filter:          PostgresTransactionFilter,
 		Scan: func(rows *sql.Rows) (*PostgresTransactionRow, error) {
 			row := &PostgresTransactionRow{}
-			if err := rows.Scan(&row.ID, &row.Description, &row.Amount, &row.CreatedAt, &row.UpdatedAt); err != nil {
+			if err := rows.Scan(row.dest()...); err != nil {
 				return nil, err
 			}
 			return row, nil
 		},
-		Entity: func(row *PostgresTransactionRow) transaction_entity.Transaction {
-			return transaction_entity.Transaction{
-				ID:          row.ID,
-				Description: row.Description,
-				Amount:      row.Amount,
-				CreatedAt:   row.CreatedAt,
-				UpdatedAt:   row.UpdatedAt,
-			}
-		},
+		Entity: newPostgresTransaction,
 		Row: func(transaction transaction_entity.Transaction) *PostgresTransactionRow {
 			return &PostgresTransactionRow{
-				ID:          transaction.ID,
+				ID:        transaction.ID,
+				AccountID: transaction.AccountID,
+				TransferID: uuid.NullUUID{
+					UUID:  transaction.TransferID,
+					Valid: transaction.IsTransfer(),
+				},
+				CategoryID: uuid.NullUUID{
+					UUID:  transaction.CategoryID,
+					Valid: transaction.IsCategorized(),
+				},
+				PayeeID: uuid.NullUUID{
+					UUID:  transaction.PayeeID,
+					Valid: transaction.HasPayee(),
+				},
+				JournalEntryID: transaction.JournalEntryID,
+				ExternalID: sql.NullString{
+					String: transaction.ExternalID,
+					Valid:  exists.String(transaction.ExternalID),
+				},
+				SubscriptionID: uuid.NullUUID{
+					UUID:  transaction.SubscriptionID,
+					Valid: transaction.IsCharge(),
+				},
+				ReversalOfID: uuid.NullUUID{
+					UUID:  transaction.ReversalOfID,
+					Valid: transaction.IsReversal(),
+				},
 				Description: transaction.Description,
-				Amount:      transaction.Amount,
-				CreatedAt:   transaction.CreatedAt,
-				UpdatedAt:   transaction.UpdatedAt,
+				Amount:      transaction.Amount.Amount,
+				Currency:    transaction.Amount.Currency,
+				Direction:   transaction.Direction.String(),
+				Label: sql.NullString{
+					String: transaction.Label,
+					Valid:  exists.String(transaction.Label),
+				},
+				Ignored: transaction.Ignored,
+				RuleLabel: sql.NullString{
+					String: transaction.RuleLabel,
+					Valid:  exists.String(transaction.RuleLabel),
+				},
+				RuleIgnored: transaction.RuleIgnored,
+				Cleared:     transaction.Cleared,
+				ReconciliationID: uuid.NullUUID{
+					UUID:  transaction.ReconciliationID,
+					Valid: transaction.IsReconciled(),
+				},
+				ValueDate: sql.NullTime{
+					Time:  transaction.ValueDate,
+					Valid: !transaction.ValueDate.IsZero(),
+				},
+				CreatedAt: transaction.CreatedAt,
+				UpdatedAt: transaction.UpdatedAt,
 			}
 		},
 		Values: func(row *PostgresTransactionRow) []any {
 			return []any{
 				row.ID,
+				row.AccountID,
+				row.TransferID,
+				row.CategoryID,
+				row.PayeeID,
+				row.JournalEntryID,
+				row.ExternalID,
+				row.SubscriptionID,
+				row.ReversalOfID,
 				row.Description,
 				row.Amount,
+				row.Currency,
+				row.Direction,
+				row.Label,
+				row.Ignored,
+				row.RuleLabel,
+				row.RuleIgnored,
+				row.Cleared,
+				row.ReconciliationID,
+				row.ValueDate,
 				row.CreatedAt,
 				row.UpdatedAt,
 			}
@@ -0,0 +1,101 @@
+package transaction_repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type PostgresDuplicateRow struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	DuplicateOfID uuid.UUID
+	Dismissed     bool
+	CreatedAt     time.Time
+}
+
+func NewPostgresDuplicateRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (DuplicateRepository, error) {
+	return postgres_repository.New[transaction_entity.Duplicate, transaction_specification.DuplicateSpecification, *PostgresDuplicateRow](postgres_repository.Option[transaction_entity.Duplicate, transaction_specification.DuplicateSpecification, *PostgresDuplicateRow]{
+		Logger:    logger,
+		TableName: "transaction_duplicates",
+		Schema: map[string]string{
+			"id":              postgres_repository.UUID,
+			"transaction_id":  postgres_repository.UUID,
+			"duplicate_of_id": postgres_repository.UUID,
+			"dismissed":       postgres_repository.Boolean,
+			"created_at":      postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"transaction_id",
+			"duplicate_of_id",
+			"dismissed",
+			"created_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...transaction_specification.DuplicateSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case transaction_specification.InvolvesSpecification:
+					where = append(where, squirrel.Or{
+						squirrel.Eq{"transaction_id": v.TransactionID},
+						squirrel.Eq{"duplicate_of_id": v.TransactionID},
+					})
+				case transaction_specification.PairIsSpecification:
+					where = append(where, squirrel.Or{
+						squirrel.Eq{"transaction_id": v.TransactionID, "duplicate_of_id": v.OtherID},
+						squirrel.Eq{"transaction_id": v.OtherID, "duplicate_of_id": v.TransactionID},
+					})
+				case transaction_specification.DismissedIsSpecification:
+					where = append(where, squirrel.Eq{"dismissed": v.Dismissed})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresDuplicateRow, error) {
+			row := &PostgresDuplicateRow{}
+			if err := rows.Scan(&row.ID, &row.TransactionID, &row.DuplicateOfID, &row.Dismissed, &row.CreatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresDuplicateRow) transaction_entity.Duplicate {
+			return transaction_entity.Duplicate{
+				ID:            row.ID,
+				TransactionID: row.TransactionID,
+				DuplicateOfID: row.DuplicateOfID,
+				Dismissed:     row.Dismissed,
+				CreatedAt:     row.CreatedAt,
+			}
+		},
+		Row: func(duplicate transaction_entity.Duplicate) *PostgresDuplicateRow {
+			return &PostgresDuplicateRow{
+				ID:            duplicate.ID,
+				TransactionID: duplicate.TransactionID,
+				DuplicateOfID: duplicate.DuplicateOfID,
+				Dismissed:     duplicate.Dismissed,
+				CreatedAt:     duplicate.CreatedAt,
+			}
+		},
+		Values: func(row *PostgresDuplicateRow) []any {
+			return []any{
+				row.ID,
+				row.TransactionID,
+				row.DuplicateOfID,
+				row.Dismissed,
+				row.CreatedAt,
+			}
+		},
+	})
+}
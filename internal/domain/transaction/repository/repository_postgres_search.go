@@ -0,0 +1,73 @@
+package transaction_repository
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+// PostgresSearchHeadline marks the matching words of the snippet.
+const PostgresSearchHeadline = "StartSel=<mark>, StopSel=</mark>, HighlightAll=true"
+
+type PostgresSearchRepository struct {
+	logger logger.Logger
+	dbm    database_manager.DatabaseManager
+}
+
+// Search ranks the transactions by how often the words of the query show up,
+// words in the description weighing more than in the label, and breaks ties
+// by the newest first. The filters should include the search, so
+// only matching transactions are ranked.
+func (r *PostgresSearchRepository) Search(ctx context.Context, query string, args common_repository.ListArgs[transaction_specification.TransactionSpecification]) (transaction_entity.Matches, error) {
+	builder := squirrel.
+		Select(Columns...).
+		Column(squirrel.Expr("ts_rank(search_vector, websearch_to_tsquery('simple', ?)) AS rank", query)).
+		Column(squirrel.Expr("ts_headline('simple', description, websearch_to_tsquery('simple', ?), ?)", query, PostgresSearchHeadline)).
+		From("transactions").
+		Where(PostgresTransactionFilter(args.Filters...)).
+		OrderBy("rank DESC", "created_at DESC", "id")
+	builder = r.dbm.Paginate(builder, args.Limit, args.Offset)
+
+	queryStr, queryArgs, err := builder.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbm.Querier(ctx).QueryContext(ctx, queryStr, queryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	matches := transaction_entity.Matches{}
+	for rows.Next() {
+		row := &PostgresTransactionRow{}
+		match := transaction_entity.Match{}
+		if err := rows.Scan(append(row.dest(), &match.Rank, &match.Snippet)...); err != nil {
+			return nil, err
+		}
+
+		match.Transaction = newPostgresTransaction(row)
+		matches = append(matches, match)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+func NewPostgresSearchRepository(logger logger.Logger, dbm database_manager.DatabaseManager) SearchRepository {
+	return &PostgresSearchRepository{
+		logger: logger,
+		dbm:    dbm,
+	}
+}
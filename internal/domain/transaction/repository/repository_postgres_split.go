@@ -0,0 +1,115 @@
+package transaction_repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	"github.com/google/uuid"
+
+	postgres_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository/postgres"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type PostgresSplitRow struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	Amount        int64
+	Currency      string
+	Label         string
+	Memo          sql.NullString
+	CreatedAt     time.Time
+}
+
+// PostgresSplitLabelIs matches transactions with at least one split line
+// labeled so, regardless of case.
+func PostgresSplitLabelIs(label string) squirrel.Sqlizer {
+	return squirrel.Expr("id IN (SELECT transaction_id FROM transaction_splits WHERE LOWER(label) = LOWER(?))", label)
+}
+
+func NewPostgresSplitRepository(logger logger.Logger, dbm database_manager.DatabaseManager) (SplitRepository, error) {
+	return postgres_repository.New[transaction_entity.Split, transaction_specification.SplitSpecification, *PostgresSplitRow](postgres_repository.Option[transaction_entity.Split, transaction_specification.SplitSpecification, *PostgresSplitRow]{
+		Logger:    logger,
+		TableName: "transaction_splits",
+		Schema: map[string]string{
+			"id":             postgres_repository.UUID,
+			"transaction_id": postgres_repository.UUID,
+			"amount":         postgres_repository.BigInt,
+			"currency":       postgres_repository.Character,
+			"label":          postgres_repository.CharacterVarying,
+			"memo":           postgres_repository.CharacterVarying,
+			"created_at":     postgres_repository.TimestampWithZone,
+		},
+		Columns: []string{
+			"id",
+			"transaction_id",
+			"amount",
+			"currency",
+			"label",
+			"memo",
+			"created_at",
+		},
+		PrimaryKey:      "id",
+		DatabaseManager: dbm,
+		Filter: func(specs ...transaction_specification.SplitSpecification) squirrel.Sqlizer {
+			where := squirrel.And{}
+			for _, spec := range specs {
+				switch v := spec.(type) {
+				case transaction_specification.TransactionIsSpecification:
+					where = append(where, squirrel.Eq{"transaction_id": v.TransactionID})
+				case transaction_specification.TransactionInSpecification:
+					where = append(where, squirrel.Eq{"transaction_id": v.TransactionIDs})
+				}
+			}
+			return where
+		},
+		Scan: func(rows *sql.Rows) (*PostgresSplitRow, error) {
+			row := &PostgresSplitRow{}
+			if err := rows.Scan(&row.ID, &row.TransactionID, &row.Amount, &row.Currency, &row.Label, &row.Memo, &row.CreatedAt); err != nil {
+				return nil, err
+			}
+			return row, nil
+		},
+		Entity: func(row *PostgresSplitRow) transaction_entity.Split {
+			return transaction_entity.Split{
+				ID:            row.ID,
+				TransactionID: row.TransactionID,
+				Amount:        common_types.NewMoney(row.Amount, row.Currency),
+				Label:         row.Label,
+				Memo:          row.Memo.String,
+				CreatedAt:     row.CreatedAt,
+			}
+		},
+		Row: func(split transaction_entity.Split) *PostgresSplitRow {
+			return &PostgresSplitRow{
+				ID:            split.ID,
+				TransactionID: split.TransactionID,
+				Amount:        split.Amount.Amount,
+				Currency:      split.Amount.Currency,
+				Label:         split.Label,
+				Memo: sql.NullString{
+					String: split.Memo,
+					Valid:  exists.String(split.Memo),
+				},
+				CreatedAt: split.CreatedAt,
+			}
+		},
+		Values: func(row *PostgresSplitRow) []any {
+			return []any{
+				row.ID,
+				row.TransactionID,
+				row.Amount,
+				row.Currency,
+				row.Label,
+				row.Memo,
+				row.CreatedAt,
+			}
+		},
+	})
+}
@@ -0,0 +1,633 @@
+package transaction_service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	attachment_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/service"
+	category_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/errors"
+	category_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/specification"
+	ledger_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/entity"
+	ledger_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/service"
+	ledger_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/types"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	payee_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/errors"
+	payee_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/specification"
+	rate_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/entity"
+	rate_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/errors"
+	rate_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/specification"
+	rule_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/entity"
+	rule_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/specification"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/service"
+	tag_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/types"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+func (s *TransactionServiceImpl) getAccount(ctx context.Context, accountID uuid.UUID) (account_entity.Account, error) {
+	account, err := s.accountRepository.Get(ctx, account_specification.WithID(accountID))
+	if err != nil {
+		return account_entity.NoAccount, err
+	}
+
+	if account == account_entity.NoAccount {
+		return account_entity.NoAccount, account_errors.ErrAccountNotFound
+	}
+
+	return account, nil
+}
+
+// newAmount denominates the amount in the currency of the account. A currency
+// given explicitly must match it.
+func newAmount(amount int64, currency string, account account_entity.Account) (common_types.Money, error) {
+	if !exists.String(currency) {
+		currency = account.Currency
+	}
+
+	money := common_types.NewMoney(amount, currency)
+	if err := money.Validate(); err != nil {
+		return common_types.NoMoney, err
+	}
+
+	if money.Currency != account.Currency {
+		return common_types.NoMoney, common_errors.ErrCurrencyMismatch
+	}
+
+	return money, nil
+}
+
+func (s *TransactionServiceImpl) checkCategory(ctx context.Context, categoryID uuid.UUID) error {
+	if categoryID == uuid.Nil {
+		return nil
+	}
+
+	exist, err := s.categoryRepository.Exist(ctx, category_specification.WithID(categoryID))
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		return category_errors.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+func (s *TransactionServiceImpl) getPayee(ctx context.Context, payeeID uuid.UUID) (payee_entity.Payee, error) {
+	if payeeID == uuid.Nil {
+		return payee_entity.NoPayee, nil
+	}
+
+	payee, err := s.payeeRepository.Get(ctx, payee_specification.WithID(payeeID))
+	if err != nil {
+		return payee_entity.NoPayee, err
+	}
+
+	if payee == payee_entity.NoPayee {
+		return payee_entity.NoPayee, payee_errors.ErrPayeeNotFound
+	}
+
+	return payee, nil
+}
+
+// resolvePayee finds the payee whose aliases match the description of the
+// transaction best. Transfers have no payee.
+func (s *TransactionServiceImpl) resolvePayee(ctx context.Context, transaction transaction_entity.Transaction) (payee_entity.Payee, error) {
+	if transaction.IsTransfer() {
+		return payee_entity.NoPayee, nil
+	}
+
+	aliases, err := s.aliasRepository.List(ctx, common_repository.ListArgs[payee_specification.AliasSpecification]{})
+	if err != nil {
+		return payee_entity.NoPayee, err
+	}
+
+	return s.getPayee(ctx, payee_entity.Aliases(aliases).Resolve(transaction.Description))
+}
+
+func (s *TransactionServiceImpl) getPayees(ctx context.Context, transactions ...transaction_entity.Transaction) (map[uuid.UUID]payee_entity.Payee, error) {
+	payeesByID := map[uuid.UUID]payee_entity.Payee{}
+
+	ids := []uuid.UUID{}
+	for _, transaction := range transactions {
+		if transaction.HasPayee() {
+			ids = append(ids, transaction.PayeeID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return payeesByID, nil
+	}
+
+	payees, err := s.payeeRepository.List(ctx, common_repository.ListArgs[payee_specification.PayeeSpecification]{
+		Filters: []payee_specification.PayeeSpecification{payee_specification.IDIn(ids...)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, payee := range payees {
+		payeesByID[payee.ID] = payee
+	}
+
+	return payeesByID, nil
+}
+
+func (s *TransactionServiceImpl) getTransaction(ctx context.Context, id uuid.UUID) (transaction_entity.Transaction, error) {
+	transaction, err := s.transactionRepository.Get(ctx, transaction_specification.WithID(id))
+	if err != nil {
+		return transaction_entity.NoTransaction, err
+	}
+
+	if transaction == transaction_entity.NoTransaction {
+		return transaction_entity.NoTransaction, transaction_errors.ErrTransactionNotFound
+	}
+
+	return transaction, nil
+}
+
+// lockTransaction gets the transaction and locks its row until the end of the
+// database transaction the context is in.
+func (s *TransactionServiceImpl) lockTransaction(ctx context.Context, id uuid.UUID) (transaction_entity.Transaction, error) {
+	transactions, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: []transaction_specification.TransactionSpecification{transaction_specification.WithID(id)},
+		Limit:   common_specification.WithLimit(1),
+		Lock:    common_specification.ForUpdate(),
+	})
+	if err != nil {
+		return transaction_entity.NoTransaction, err
+	}
+
+	if len(transactions) == 0 {
+		return transaction_entity.NoTransaction, transaction_errors.ErrTransactionNotFound
+	}
+
+	return transactions[0], nil
+}
+
+func (s *TransactionServiceImpl) getCounterpart(ctx context.Context, transaction transaction_entity.Transaction) (transaction_entity.Transaction, error) {
+	counterpart, err := s.transactionRepository.Get(ctx, transaction_specification.TransferIs(transaction.TransferID), transaction_specification.WithoutID(transaction.ID))
+	if err != nil {
+		return transaction_entity.NoTransaction, err
+	}
+
+	if counterpart == transaction_entity.NoTransaction {
+		return transaction_entity.NoTransaction, transaction_errors.ErrTransactionNotFound
+	}
+
+	return counterpart, nil
+}
+
+func (s *TransactionServiceImpl) applyRules(ctx context.Context, transactions ...*transaction_entity.Transaction) error {
+	rules, err := s.ruleRepository.List(ctx, common_repository.ListArgs[rule_specification.RuleSpecification]{})
+	if err != nil {
+		return err
+	}
+
+	for _, transaction := range transactions {
+		*transaction = rule_entity.Rules(rules).Apply(*transaction)
+	}
+
+	return nil
+}
+
+func (s *TransactionServiceImpl) getRates(ctx context.Context, currency string) (rate_entity.Rates, error) {
+	return s.rateRepository.List(ctx, common_repository.ListArgs[rate_specification.RateSpecification]{
+		Filters: []rate_specification.RateSpecification{rate_specification.Involves(currency)},
+	})
+}
+
+func convertAmount(rates rate_entity.Rates, currency string, transaction transaction_entity.Transaction) (common_types.Money, error) {
+	amount, ok := rates.Convert(transaction.Amount, currency, transaction.CreatedAt)
+	if !ok {
+		return common_types.NoMoney, rate_errors.ErrRateNotFound.Format(transaction.Amount.Currency, currency, transaction.CreatedAt.Format(time.DateOnly))
+	}
+
+	return amount, nil
+}
+
+// search lists the page of transactions matching the query, most relevant
+// first, along with their rank and snippet.
+func (s *TransactionServiceImpl) search(ctx context.Context, query string, filters []transaction_specification.TransactionSpecification, pagination common_service.PaginationParams) ([]transaction_entity.Transaction, map[uuid.UUID]transaction_entity.Match, error) {
+	matches, err := s.searchRepository.Search(ctx, query, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: filters,
+		Limit:   common_specification.WithLimit(pagination.Limit()),
+		Offset:  common_specification.WithOffset(pagination.Offset()),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transactions := []transaction_entity.Transaction{}
+	byID := map[uuid.UUID]transaction_entity.Match{}
+	for _, match := range matches {
+		transactions = append(transactions, match.Transaction)
+		byID[match.Transaction.ID] = match
+	}
+
+	return transactions, byID, nil
+}
+
+// convert converts the amount of every transaction into the currency using the
+// rate effective on the transaction date.
+func (s *TransactionServiceImpl) convert(ctx context.Context, currency string, transactions ...transaction_entity.Transaction) (map[uuid.UUID]common_types.Money, error) {
+	currency = common_types.NormalizeCurrency(currency)
+	if !common_types.IsCurrency(currency) {
+		return nil, common_errors.ErrCurrencyInvalid
+	}
+
+	rates, err := s.getRates(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := map[uuid.UUID]common_types.Money{}
+	for _, transaction := range transactions {
+		converted[transaction.ID], err = convertAmount(rates, currency, transaction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return converted, nil
+}
+
+type SplitParams struct {
+	Amount int64
+	Label  string
+	Memo   string
+}
+
+// newSplits builds the split lines of the transaction in its currency and
+// checks they add up to its amount.
+func newSplits(transaction transaction_entity.Transaction, params []SplitParams) (transaction_entity.Splits, error) {
+	if len(params) > 0 && transaction.IsTransfer() {
+		return nil, transaction_errors.ErrTransactionSplitTransfer
+	}
+
+	splits := transaction_entity.Splits{}
+	for _, p := range params {
+		if !exists.String(p.Label) {
+			return nil, transaction_errors.ErrTransactionSplitLabelEmpty
+		}
+
+		if p.Amount <= 0 {
+			return nil, transaction_errors.ErrTransactionSplitAmountInvalid
+		}
+
+		splits = append(splits, transaction_entity.Split{
+			ID:            uuid.New(),
+			TransactionID: transaction.ID,
+			Amount:        common_types.NewMoney(p.Amount, transaction.Amount.Currency),
+			Label:         strings.TrimSpace(p.Label),
+			Memo:          p.Memo,
+			CreatedAt:     transaction.UpdatedAt,
+		})
+	}
+
+	if !splits.IsBalanced(transaction) {
+		return nil, transaction_errors.ErrTransactionSplitsUnbalanced
+	}
+
+	return splits, nil
+}
+
+// setSplits replaces the split lines of the transaction.
+func (s *TransactionServiceImpl) setSplits(ctx context.Context, transaction transaction_entity.Transaction, splits transaction_entity.Splits) error {
+	return s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		if err := s.splitRepository.Delete(ctx, transaction_specification.TransactionIs(transaction.ID)); err != nil {
+			return err
+		}
+
+		for _, split := range splits {
+			if err := s.splitRepository.Save(ctx, split); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *TransactionServiceImpl) getSplits(ctx context.Context, transactions ...transaction_entity.Transaction) (map[uuid.UUID]transaction_entity.Splits, error) {
+	ids := []uuid.UUID{}
+	for _, transaction := range transactions {
+		ids = append(ids, transaction.ID)
+	}
+
+	splits, err := s.splitRepository.List(ctx, common_repository.ListArgs[transaction_specification.SplitSpecification]{
+		Filters: []transaction_specification.SplitSpecification{transaction_specification.TransactionIn(ids...)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	splitsByID := map[uuid.UUID]transaction_entity.Splits{}
+	for _, split := range splits {
+		splitsByID[split.TransactionID] = append(splitsByID[split.TransactionID], split)
+	}
+
+	return splitsByID, nil
+}
+
+// getReversals returns the reversals of the transactions by the id of the
+// transaction they reverse.
+func (s *TransactionServiceImpl) getReversals(ctx context.Context, transactions ...transaction_entity.Transaction) (map[uuid.UUID]transaction_entity.Transactions, error) {
+	reversalsByID := map[uuid.UUID]transaction_entity.Transactions{}
+
+	ids := []uuid.UUID{}
+	for _, transaction := range transactions {
+		if !transaction.IsTransfer() && !transaction.IsReversal() {
+			ids = append(ids, transaction.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return reversalsByID, nil
+	}
+
+	reversals, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: []transaction_specification.TransactionSpecification{transaction_specification.ReversalOf(ids...)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reversal := range reversals {
+		reversalsByID[reversal.ReversalOfID] = append(reversalsByID[reversal.ReversalOfID], reversal)
+	}
+
+	return reversalsByID, nil
+}
+
+// checkReversals refuses an amount that would leave more reversed than the
+// original amount, whether the transaction is the original or one of its
+// reversals. Transfers are never reversed.
+func (s *TransactionServiceImpl) checkReversals(ctx context.Context, transaction transaction_entity.Transaction, reversals transaction_entity.Transactions) error {
+	if transaction.IsTransfer() {
+		return nil
+	}
+
+	original := transaction
+	if transaction.IsReversal() {
+		var err error
+		original, err = s.getTransaction(ctx, transaction.ReversalOfID)
+		if err != nil {
+			return err
+		}
+
+		reversalsByID, err := s.getReversals(ctx, original)
+		if err != nil {
+			return err
+		}
+
+		reversals = transaction_entity.Transactions{}
+		for _, reversal := range reversalsByID[original.ID] {
+			if reversal.ID == transaction.ID {
+				reversal = transaction
+			}
+
+			reversals = append(reversals, reversal)
+		}
+	}
+
+	if net := original.NetAmount(reversals); net.IsNegative() {
+		return transaction_errors.ErrTransactionReversalExceeded.Format(net.Neg())
+	}
+
+	return nil
+}
+
+func (s *TransactionServiceImpl) setTags(ctx context.Context, transaction transaction_entity.Transaction, names []string) (tag_entity.Tags, error) {
+	result, err := s.tagService.SetTags(ctx, &tag_service.SetTagsParams{
+		Target:   tag_types.TransactionTarget,
+		TaggedID: transaction.ID,
+		Names:    names,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Tags, nil
+}
+
+func (s *TransactionServiceImpl) getTags(ctx context.Context, transactions ...transaction_entity.Transaction) (map[uuid.UUID]tag_entity.Tags, error) {
+	ids := []uuid.UUID{}
+	for _, transaction := range transactions {
+		ids = append(ids, transaction.ID)
+	}
+
+	result, err := s.tagService.GetTags(ctx, &tag_service.GetTagsParams{
+		Target:    tag_types.TransactionTarget,
+		TaggedIDs: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Tags, nil
+}
+
+// record runs the rules against the transactions sharing one journal entry,
+// posts that entry to the ledger and saves them in the same database
+// transaction. The transactions are updated in place with the rule results and
+// the entry they are projected onto, a new one when they were recorded before.
+// The postings reference the transactions before they are saved, which the
+// deferred foreign key allows until commit.
+func (s *TransactionServiceImpl) record(ctx context.Context, transactions ...*transaction_entity.Transaction) error {
+	return s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		if err := s.applyRules(ctx, transactions...); err != nil {
+			return err
+		}
+
+		recorded := transaction_entity.Transactions{}
+		for _, transaction := range transactions {
+			recorded = append(recorded, *transaction)
+		}
+
+		result, err := s.ledgerService.PostJournalEntry(ctx, &ledger_service.PostJournalEntryParams{
+			JournalEntry: s.newJournalEntry(recorded...),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, transaction := range transactions {
+			transaction.JournalEntryID = result.JournalEntry.ID
+
+			if err := s.transactionRepository.Save(ctx, *transaction); err != nil {
+				return err
+			}
+
+			if err := s.flagDuplicates(ctx, *transaction); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// flagDuplicates flags every transaction looking like the given one, unless
+// the pair was flagged before, so dismissed pairs stay dismissed.
+func (s *TransactionServiceImpl) flagDuplicates(ctx context.Context, transaction transaction_entity.Transaction) error {
+	if transaction.IsTransfer() {
+		return nil
+	}
+
+	candidates, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: []transaction_specification.TransactionSpecification{
+			transaction_specification.WithoutID(transaction.ID),
+			transaction_specification.AccountIs(transaction.AccountID),
+			transaction_specification.AmountIs(transaction.Amount),
+			transaction_specification.CreatedAfter(transaction.CreatedAt.Add(-transaction_entity.DuplicateWindow)),
+			transaction_specification.CreatedBefore(transaction.CreatedAt.Add(transaction_entity.DuplicateWindow)),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		if !transaction.IsDuplicateOf(candidate) {
+			continue
+		}
+
+		flagged, err := s.duplicateRepository.Exist(ctx, transaction_specification.PairIs(transaction.ID, candidate.ID))
+		if err != nil {
+			return err
+		}
+
+		if flagged {
+			continue
+		}
+
+		if err := s.duplicateRepository.Save(ctx, transaction_entity.Duplicate{
+			ID:            uuid.New(),
+			TransactionID: transaction.ID,
+			DuplicateOfID: candidate.ID,
+			CreatedAt:     time.Now(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unrecord voids the journal entry and removes every transaction projected
+// from it, within one database transaction. The voided entry keeps its
+// postings, which no longer point to a transaction once it is gone. A
+// transaction that has been reversed is kept, its row locked so no reversal
+// is recorded while it goes.
+func (s *TransactionServiceImpl) unrecord(ctx context.Context, transaction transaction_entity.Transaction) error {
+	if transaction.IsReconciled() {
+		return transaction_errors.ErrTransactionReconciled
+	}
+
+	return s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		if _, err := s.lockTransaction(ctx, transaction.ID); err != nil {
+			return err
+		}
+
+		reversals, err := s.getReversals(ctx, transaction)
+		if err != nil {
+			return err
+		}
+
+		if len(reversals[transaction.ID]) > 0 {
+			return transaction_errors.ErrTransactionReversed
+		}
+
+		if _, err := s.ledgerService.VoidJournalEntry(ctx, &ledger_service.VoidJournalEntryParams{
+			ID: transaction.JournalEntryID,
+		}); err != nil {
+			return err
+		}
+
+		ids := []uuid.UUID{transaction.ID}
+		spec := transaction_specification.WithID(transaction.ID)
+
+		if transaction.IsTransfer() {
+			counterpart, err := s.getCounterpart(ctx, transaction)
+			if err != nil {
+				return err
+			}
+
+			ids = append(ids, counterpart.ID)
+			spec = transaction_specification.TransferIs(transaction.TransferID)
+		}
+
+		if _, err := s.attachmentService.DeleteAttachments(ctx, &attachment_service.DeleteAttachmentsParams{
+			TransactionIDs: ids,
+		}); err != nil {
+			return err
+		}
+
+		return s.transactionRepository.Delete(ctx, spec)
+	})
+}
+
+// newJournalEntry balances every transaction against the income or expenses
+// account. A reversal is balanced against the account of the transaction it
+// reverses, so a refund lowers the expenses instead of raising the income.
+func (s *TransactionServiceImpl) newJournalEntry(transactions ...transaction_entity.Transaction) ledger_entity.JournalEntry {
+	entry := ledger_entity.JournalEntry{
+		ID:          transactions[0].JournalEntryID,
+		Description: transactions[0].Description,
+		PostedAt:    transactions[0].CreatedAt,
+		Postings:    ledger_entity.Postings{},
+	}
+
+	for _, transaction := range transactions {
+		entry.Postings = append(entry.Postings, ledger_entity.Posting{
+			TransactionID: transaction.ID,
+			Account:       ledger_types.Assets(transaction.AccountID),
+			Amount:        transaction.SignedAmount(),
+		})
+
+		switch {
+		case transaction.IsReversal() && transaction.Direction == transaction_types.Income:
+			entry.Postings = append(entry.Postings, ledger_entity.Posting{
+				TransactionID: transaction.ID,
+				Account:       ledger_types.Expenses,
+				Amount:        transaction.Amount.Neg(),
+			})
+		case transaction.IsReversal() && transaction.Direction == transaction_types.Expense:
+			entry.Postings = append(entry.Postings, ledger_entity.Posting{
+				TransactionID: transaction.ID,
+				Account:       ledger_types.Income,
+				Amount:        transaction.Amount,
+			})
+		case transaction.Direction == transaction_types.Income:
+			entry.Postings = append(entry.Postings, ledger_entity.Posting{
+				TransactionID: transaction.ID,
+				Account:       ledger_types.Income,
+				Amount:        transaction.Amount.Neg(),
+			})
+		case transaction.Direction == transaction_types.Expense:
+			entry.Postings = append(entry.Postings, ledger_entity.Posting{
+				TransactionID: transaction.ID,
+				Account:       ledger_types.Expenses,
+				Amount:        transaction.Amount,
+			})
+		}
+	}
+
+	return entry
+}
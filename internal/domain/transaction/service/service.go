@@ -2,14 +2,30 @@ package transaction_service
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
 	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
 
+	account_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/repository"
+	attachment_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/service"
+	category_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/repository"
+	ledger_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/service"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	payee_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/repository"
+	rate_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/repository"
+	rule_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/repository"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	tag_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/service"
 	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
 	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
 	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
 	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
 
 	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
 	"github.com/google/uuid"
@@ -18,6 +34,14 @@ import (
 type TransactionService interface {
 	GetTransaction(ctx context.Context, params *GetTransactionParams) (*GetTransactionResult, error)
 	ListTransactions(ctx context.Context, params *ListTransactionsParams) (*ListTransactionsResult, error)
+	CreateTransaction(ctx context.Context, params *CreateTransactionParams) (*CreateTransactionResult, error)
+	UpdateTransaction(ctx context.Context, params *UpdateTransactionParams) (*UpdateTransactionResult, error)
+	DeleteTransaction(ctx context.Context, params *DeleteTransactionParams) (*DeleteTransactionResult, error)
+	SummarizeTransactions(ctx context.Context, params *SummarizeTransactionsParams) (*SummarizeTransactionsResult, error)
+	CreateTransfer(ctx context.Context, params *CreateTransferParams) (*CreateTransferResult, error)
+	ListDuplicates(ctx context.Context, params *ListDuplicatesParams) (*ListDuplicatesResult, error)
+	ResolveDuplicates(ctx context.Context, params *ResolveDuplicatesParams) (*ResolveDuplicatesResult, error)
+	ReverseTransaction(ctx context.Context, params *ReverseTransactionParams) (*ReverseTransactionResult, error)
 }
 
 type GetTransactionParams struct {
@@ -26,20 +50,135 @@ type GetTransactionParams struct {
 
 type GetTransactionResult struct {
 	Transaction transaction_entity.Transaction
+	Payee       payee_entity.Payee
+	Splits      transaction_entity.Splits
+	Tags        tag_entity.Tags
+	Reversals   transaction_entity.Transactions
 }
 
-type ListTransactionsParams struct {
+type FilterTransactionsParams struct {
+	// Search is a full-text query in web search syntax over the description
+	// and label.
+	Search          string
 	DescriptionLike string
-	Pagination      common_service.PaginationParams
+	DirectionIs     transaction_types.Direction
+	AccountIs       uuid.UUID
+	CategoryIs      uuid.UUID
+	PayeeIs         uuid.UUID
+	CurrencyIs      string
+	HasAnyTag       []string
+	HasAllTags      []string
+	SplitLabelIs    string
+	// AmountFrom and AmountTo bound the absolute amount in minor units,
+	// regardless of the direction, on the sides given. They do not compare
+	// the currency, which CurrencyIs narrows down.
+	AmountFrom common_types.Maybe[int64]
+	AmountTo   common_types.Maybe[int64]
+	// The date ranges include both bounds and are open on a side left zero.
+	CreatedFrom           time.Time
+	CreatedTo             time.Time
+	UpdatedFrom           time.Time
+	UpdatedTo             time.Time
+	ChargedBySubscription uuid.UUID
+}
+
+func (params FilterTransactionsParams) Specifications() []transaction_specification.TransactionSpecification {
+	filters := []transaction_specification.TransactionSpecification{}
+
+	if exists.String(params.Search) {
+		filters = append(filters, transaction_specification.Search(strings.TrimSpace(params.Search)))
+	}
+
+	if exists.String(params.DescriptionLike) {
+		filters = append(filters, transaction_specification.DescriptionLike(params.DescriptionLike))
+	}
+
+	if params.DirectionIs != transaction_types.NoDirection {
+		filters = append(filters, transaction_specification.DirectionIs(params.DirectionIs))
+	}
+
+	if params.AccountIs != uuid.Nil {
+		filters = append(filters, transaction_specification.AccountIs(params.AccountIs))
+	}
+
+	if params.CategoryIs != uuid.Nil {
+		filters = append(filters, transaction_specification.CategoryIs(params.CategoryIs))
+	}
+
+	if params.PayeeIs != uuid.Nil {
+		filters = append(filters, transaction_specification.PayeeIs(params.PayeeIs))
+	}
+
+	if exists.String(params.CurrencyIs) {
+		filters = append(filters, transaction_specification.CurrencyIs(common_types.NormalizeCurrency(params.CurrencyIs)))
+	}
+
+	if exists.String(params.SplitLabelIs) {
+		filters = append(filters, transaction_specification.SplitLabelIs(strings.TrimSpace(params.SplitLabelIs)))
+	}
+
+	if names := tag_entity.NormalizeNames(params.HasAnyTag); len(names) > 0 {
+		filters = append(filters, transaction_specification.HasAnyTag(names...))
+	}
+
+	if names := tag_entity.NormalizeNames(params.HasAllTags); len(names) > 0 {
+		filters = append(filters, transaction_specification.HasAllTags(names...))
+	}
+
+	if params.AmountFrom.Present || params.AmountTo.Present {
+		filters = append(filters, transaction_specification.AmountBetween(params.AmountFrom, params.AmountTo))
+	}
+
+	if exists.Date(params.CreatedFrom) || exists.Date(params.CreatedTo) {
+		filters = append(filters, transaction_specification.CreatedBetween(params.CreatedFrom, params.CreatedTo))
+	}
+
+	if exists.Date(params.UpdatedFrom) || exists.Date(params.UpdatedTo) {
+		filters = append(filters, transaction_specification.UpdatedBetween(params.UpdatedFrom, params.UpdatedTo))
+	}
+
+	if params.ChargedBySubscription != uuid.Nil {
+		filters = append(filters, transaction_specification.ChargedBySubscription(params.ChargedBySubscription))
+	}
+
+	return filters
+}
+
+type ListTransactionsParams struct {
+	FilterTransactionsParams
+	// ConvertTo, when set, converts every amount into the currency using the
+	// rate effective on the transaction date.
+	ConvertTo  string
+	Pagination common_service.PaginationParams
 }
 
 type ListTransactionsResult struct {
 	Pagination   common_service.PaginationResult
 	Transactions []transaction_entity.Transaction
+	Payees       map[uuid.UUID]payee_entity.Payee
+	Splits       map[uuid.UUID]transaction_entity.Splits
+	Tags         map[uuid.UUID]tag_entity.Tags
+	Reversals    map[uuid.UUID]transaction_entity.Transactions
+	Converted    map[uuid.UUID]common_types.Money
+	// Matches holds the rank and snippet of every transaction when searching.
+	Matches map[uuid.UUID]transaction_entity.Match
 }
 
 type TransactionServiceImpl struct {
 	transactionRepository transaction_repository.TransactionRepository
+	searchRepository      transaction_repository.SearchRepository
+	splitRepository       transaction_repository.SplitRepository
+	duplicateRepository   transaction_repository.DuplicateRepository
+	accountRepository     account_repository.AccountRepository
+	categoryRepository    category_repository.CategoryRepository
+	payeeRepository       payee_repository.PayeeRepository
+	aliasRepository       payee_repository.AliasRepository
+	ruleRepository        rule_repository.RuleRepository
+	rateRepository        rate_repository.RateRepository
+	tagService            tag_service.TagService
+	attachmentService     attachment_service.AttachmentService
+	transactionManager    transaction_manager.TransactionManager
+	ledgerService         ledger_service.LedgerService
 }
 
 // GetTranscation implements TransactionService.
@@ -53,25 +192,52 @@ func (s *TransactionServiceImpl) GetTransaction(ctx context.Context, params *Get
 		return nil, transaction_errors.ErrTransactionNotFound
 	}
 
+	payee, err := s.getPayee(ctx, transaction.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := s.getSplits(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.getTags(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	reversals, err := s.getReversals(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
 	return &GetTransactionResult{
 		Transaction: transaction,
+		Payee:       payee,
+		Splits:      splits[transaction.ID],
+		Tags:        tags[transaction.ID],
+		Reversals:   reversals[transaction.ID],
 	}, nil
 }
 
 func (s *TransactionServiceImpl) ListTransactions(ctx context.Context, params *ListTransactionsParams) (*ListTransactionsResult, error) {
-	filters := []transaction_specification.TransactionSpecification{}
-
-	if exists.String(params.DescriptionLike) {
-		filters = append(filters, transaction_specification.DescriptionLike(params.DescriptionLike))
-	}
+	filters := params.Specifications()
 
 	params.Pagination = params.Pagination.Normalize()
 
-	transactions, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
-		Filters: filters,
-		Limit:   params.Pagination.Limit(),
-		Offset:  params.Pagination.Offset(),
-	})
+	var transactions []transaction_entity.Transaction
+	var matches map[uuid.UUID]transaction_entity.Match
+	var err error
+	if exists.String(params.Search) {
+		transactions, matches, err = s.search(ctx, strings.TrimSpace(params.Search), filters, params.Pagination)
+	} else {
+		transactions, err = s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+			Filters: filters,
+			Limit:   common_specification.WithLimit(params.Pagination.Limit()),
+			Offset:  common_specification.WithOffset(params.Pagination.Offset()),
+		})
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -81,14 +247,75 @@ func (s *TransactionServiceImpl) ListTransactions(ctx context.Context, params *L
 		return nil, err
 	}
 
+	payees, err := s.getPayees(ctx, transactions...)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := s.getSplits(ctx, transactions...)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.getTags(ctx, transactions...)
+	if err != nil {
+		return nil, err
+	}
+
+	reversals, err := s.getReversals(ctx, transactions...)
+	if err != nil {
+		return nil, err
+	}
+
+	var converted map[uuid.UUID]common_types.Money
+	if exists.String(params.ConvertTo) {
+		converted, err = s.convert(ctx, params.ConvertTo, transactions...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &ListTransactionsResult{
 		Pagination:   common_service.NewPaginationResult(params.Pagination, size),
 		Transactions: transactions,
+		Payees:       payees,
+		Splits:       splits,
+		Tags:         tags,
+		Reversals:    reversals,
+		Converted:    converted,
+		Matches:      matches,
 	}, nil
 }
 
-func New(transactionRepository transaction_repository.TransactionRepository) TransactionService {
+func New(
+	transactionRepository transaction_repository.TransactionRepository,
+	searchRepository transaction_repository.SearchRepository,
+	splitRepository transaction_repository.SplitRepository,
+	duplicateRepository transaction_repository.DuplicateRepository,
+	accountRepository account_repository.AccountRepository,
+	categoryRepository category_repository.CategoryRepository,
+	payeeRepository payee_repository.PayeeRepository,
+	aliasRepository payee_repository.AliasRepository,
+	ruleRepository rule_repository.RuleRepository,
+	rateRepository rate_repository.RateRepository,
+	tagService tag_service.TagService,
+	attachmentService attachment_service.AttachmentService,
+	transactionManager transaction_manager.TransactionManager,
+	ledgerService ledger_service.LedgerService) TransactionService {
 	return &TransactionServiceImpl{
 		transactionRepository: transactionRepository,
+		searchRepository:      searchRepository,
+		splitRepository:       splitRepository,
+		duplicateRepository:   duplicateRepository,
+		accountRepository:     accountRepository,
+		categoryRepository:    categoryRepository,
+		payeeRepository:       payeeRepository,
+		aliasRepository:       aliasRepository,
+		ruleRepository:        ruleRepository,
+		rateRepository:        rateRepository,
+		tagService:            tagService,
+		attachmentService:     attachmentService,
+		transactionManager:    transactionManager,
+		ledgerService:         ledgerService,
 	}
 }
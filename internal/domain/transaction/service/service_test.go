@@ -0,0 +1,350 @@
+package transaction_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/repository"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	account_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/types"
+	attachment_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/repository"
+	attachment_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/service"
+	category_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/repository"
+	ledger_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/repository"
+	ledger_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/service"
+	ledger_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/specification"
+	ledger_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/types"
+	payee_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/repository"
+	rate_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/repository"
+	rule_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/repository"
+	tag_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/repository"
+	tag_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/service"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/db/dbtest"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/storage"
+	database_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/database"
+	transaction_manager "github.com/fikrirnurhidayat/banda-lumaksa/internal/manager/transaction"
+)
+
+// TestTransactionRoundTrip records transactions on two fresh accounts of the
+// database in DATABASE_URL, then deletes them again, checking that each one
+// is stored together with its balanced journal entry and that the entry is
+// voided, not removed, once the transaction is gone.
+func TestTransactionRoundTrip(t *testing.T) {
+	db := dbtest.Open(t)
+	log := logger.New("test", "test")
+	dbm := database_manager.New(log, db)
+	tm := transaction_manager.New(log, db)
+
+	must := func(t *testing.T, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	accountRepository, err := account_repository.NewPostgresRepository(log, dbm)
+	must(t, err)
+	transactionRepository, err := transaction_repository.NewPostgresRepository(log, dbm)
+	must(t, err)
+	splitRepository, err := transaction_repository.NewPostgresSplitRepository(log, dbm)
+	must(t, err)
+	duplicateRepository, err := transaction_repository.NewPostgresDuplicateRepository(log, dbm)
+	must(t, err)
+	journalEntryRepository, err := ledger_repository.NewPostgresJournalEntryRepository(log, dbm)
+	must(t, err)
+	postingRepository, err := ledger_repository.NewPostgresPostingRepository(log, dbm)
+	must(t, err)
+	categoryRepository, err := category_repository.NewPostgresRepository(log, dbm)
+	must(t, err)
+	payeeRepository, err := payee_repository.NewPostgresRepository(log, dbm)
+	must(t, err)
+	aliasRepository, err := payee_repository.NewPostgresAliasRepository(log, dbm)
+	must(t, err)
+	ruleRepository, err := rule_repository.NewPostgresRepository(log, dbm)
+	must(t, err)
+	rateRepository, err := rate_repository.NewPostgresRepository(log, dbm)
+	must(t, err)
+	tagRepository, err := tag_repository.NewPostgresRepository(log, dbm)
+	must(t, err)
+	transactionTaggingRepository, err := tag_repository.NewPostgresTransactionTaggingRepository(log, dbm)
+	must(t, err)
+	subscriptionTaggingRepository, err := tag_repository.NewPostgresSubscriptionTaggingRepository(log, dbm)
+	must(t, err)
+	attachmentRepository, err := attachment_repository.NewPostgresRepository(log, dbm)
+	must(t, err)
+	store, err := storage.NewLocal(t.TempDir())
+	must(t, err)
+
+	service := New(
+		transactionRepository,
+		transaction_repository.NewPostgresSearchRepository(log, dbm),
+		splitRepository,
+		duplicateRepository,
+		accountRepository,
+		categoryRepository,
+		payeeRepository,
+		aliasRepository,
+		ruleRepository,
+		rateRepository,
+		tag_service.New(log, tagRepository, transactionTaggingRepository, subscriptionTaggingRepository, tm),
+		attachment_service.New(log, attachmentRepository, transactionRepository, store, tm),
+		tm,
+		ledger_service.New(log, journalEntryRepository, postingRepository, tm),
+	)
+
+	// checkRecorded asserts that the transactions are stored, or gone when
+	// recorded is false, and that their balanced journal entry is kept either
+	// way, voided once they are gone.
+	checkRecorded := func(t *testing.T, recorded bool, transactions ...transaction_entity.Transaction) {
+		t.Helper()
+		ctx := context.Background()
+
+		for _, transaction := range transactions {
+			stored, err := transactionRepository.Exist(ctx, transaction_specification.WithID(transaction.ID))
+			must(t, err)
+			if stored != recorded {
+				t.Errorf("transaction %s stored = %v, want %v", transaction.ID, stored, recorded)
+			}
+
+			entry, err := journalEntryRepository.Get(ctx, ledger_specification.WithID(transaction.JournalEntryID))
+			must(t, err)
+			if entry.ID == uuid.Nil {
+				t.Errorf("journal entry %s is gone", transaction.JournalEntryID)
+			}
+
+			if entry.IsVoided() == recorded {
+				t.Errorf("journal entry %s voided = %v, want %v", transaction.JournalEntryID, entry.IsVoided(), !recorded)
+			}
+
+			postings, err := postingRepository.List(ctx, common_repository.ListArgs[ledger_specification.PostingSpecification]{
+				Filters: []ledger_specification.PostingSpecification{ledger_specification.JournalEntryIs(transaction.JournalEntryID)},
+			})
+			must(t, err)
+
+			sum := int64(0)
+			for _, posting := range postings {
+				sum += posting.Amount.Amount
+			}
+
+			if len(postings) == 0 || sum != 0 {
+				t.Errorf("journal entry %s has %d postings summing to %d", transaction.JournalEntryID, len(postings), sum)
+			}
+		}
+	}
+
+	tests := []struct {
+		name string
+		// record creates the transactions between the two accounts, which
+		// are deleted again in reverse order.
+		record func(ctx context.Context, source, destination account_entity.Account) (transaction_entity.Transactions, error)
+		// wantDeleteErr is the error deleting the first transaction before
+		// the others fails with, when set.
+		wantDeleteErr error
+	}{
+		{
+			name: "income",
+			record: func(ctx context.Context, source, _ account_entity.Account) (transaction_entity.Transactions, error) {
+				result, err := service.CreateTransaction(ctx, &CreateTransactionParams{
+					AccountID:   source.ID,
+					Description: "Round trip income",
+					Amount:      150000,
+					Currency:    source.Currency,
+					Direction:   transaction_types.Income,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				return transaction_entity.Transactions{result.Transaction}, nil
+			},
+		},
+		{
+			name: "expense",
+			record: func(ctx context.Context, source, _ account_entity.Account) (transaction_entity.Transactions, error) {
+				result, err := service.CreateTransaction(ctx, &CreateTransactionParams{
+					AccountID:   source.ID,
+					Description: "Round trip expense",
+					Amount:      150000,
+					Currency:    source.Currency,
+					Direction:   transaction_types.Expense,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				return transaction_entity.Transactions{result.Transaction}, nil
+			},
+		},
+		{
+			name: "transfer",
+			record: func(ctx context.Context, source, destination account_entity.Account) (transaction_entity.Transactions, error) {
+				result, err := service.CreateTransfer(ctx, &CreateTransferParams{
+					SourceAccountName:      source.Name,
+					DestinationAccountName: destination.Name,
+					Description:            "Round trip transfer",
+					Amount:                 250000,
+					Currency:               source.Currency,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				return transaction_entity.Transactions{result.Transfer.Outgoing, result.Transfer.Incoming}, nil
+			},
+		},
+		{
+			name: "update",
+			record: func(ctx context.Context, source, _ account_entity.Account) (transaction_entity.Transactions, error) {
+				created, err := service.CreateTransaction(ctx, &CreateTransactionParams{
+					AccountID:   source.ID,
+					Description: "Round trip corrected purchase",
+					Amount:      100000,
+					Currency:    source.Currency,
+					Direction:   transaction_types.Expense,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				updated, err := service.UpdateTransaction(ctx, &UpdateTransactionParams{
+					ID:     created.Transaction.ID,
+					Amount: common_types.Maybe[int64]{Present: true, Value: 120000},
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				entry, err := journalEntryRepository.Get(ctx, ledger_specification.WithID(created.Transaction.JournalEntryID))
+				if err != nil {
+					return nil, err
+				}
+
+				if !entry.IsVoided() || updated.Transaction.JournalEntryID == entry.ID {
+					return nil, fmt.Errorf("journal entry %s was rewritten instead of voided", entry.ID)
+				}
+
+				return transaction_entity.Transactions{updated.Transaction}, nil
+			},
+		},
+		{
+			name: "reversal",
+			record: func(ctx context.Context, source, _ account_entity.Account) (transaction_entity.Transactions, error) {
+				created, err := service.CreateTransaction(ctx, &CreateTransactionParams{
+					AccountID:   source.ID,
+					Description: "Round trip refunded purchase",
+					Amount:      100000,
+					Currency:    source.Currency,
+					Direction:   transaction_types.Expense,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				reversed, err := service.ReverseTransaction(ctx, &ReverseTransactionParams{ID: created.Transaction.ID, Amount: 40000})
+				if err != nil {
+					return nil, err
+				}
+
+				_, err = service.ReverseTransaction(ctx, &ReverseTransactionParams{ID: created.Transaction.ID, Amount: 60001})
+				if e := (*common_errors.Error)(nil); !errors.As(err, &e) || e.Reason != transaction_errors.ErrTransactionReversalExceeded.Reason {
+					return nil, fmt.Errorf("reversing past the amount: got %v, want %s", err, transaction_errors.ErrTransactionReversalExceeded.Reason)
+				}
+
+				return transaction_entity.Transactions{created.Transaction, reversed.Reversal}, nil
+			},
+			wantDeleteErr: transaction_errors.ErrTransactionReversed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			accounts := make([]account_entity.Account, 2)
+			for i := range accounts {
+				now := time.Now()
+				accounts[i] = account_entity.Account{
+					ID:        uuid.New(),
+					Name:      "Round trip " + uuid.NewString(),
+					Type:      account_types.Bank,
+					Currency:  "IDR",
+					CreatedAt: now,
+					UpdatedAt: now,
+				}
+
+				must(t, accountRepository.Save(ctx, accounts[i]))
+
+				// The accounts are removed once the test is done, by which
+				// time their transactions must be gone.
+				id := accounts[i].ID
+				t.Cleanup(func() {
+					if err := accountRepository.Delete(context.Background(), account_specification.WithID(id)); err != nil {
+						t.Error(err)
+					}
+				})
+			}
+
+			transactions, err := tt.record(ctx, accounts[0], accounts[1])
+			if err != nil {
+				t.Fatalf("recording error = %v", err)
+			}
+
+			checkRecorded(t, true, transactions...)
+
+			if tt.wantDeleteErr != nil {
+				if _, err := service.DeleteTransaction(ctx, &DeleteTransactionParams{ID: transactions[0].ID}); !errors.Is(err, tt.wantDeleteErr) {
+					t.Fatalf("DeleteTransaction() error = %v, want %v", err, tt.wantDeleteErr)
+				}
+
+				checkRecorded(t, true, transactions...)
+			}
+
+			// Deleting one leg of a transfer removes the other one too.
+			for i := len(transactions) - 1; i >= 0; i-- {
+				stored, err := transactionRepository.Exist(ctx, transaction_specification.WithID(transactions[i].ID))
+				must(t, err)
+				if !stored {
+					continue
+				}
+
+				if _, err := service.DeleteTransaction(ctx, &DeleteTransactionParams{ID: transactions[i].ID}); err != nil {
+					t.Fatalf("DeleteTransaction() error = %v", err)
+				}
+			}
+
+			checkRecorded(t, false, transactions...)
+
+			// The reversing entries leave nothing on the accounts.
+			for _, account := range accounts {
+				postings, err := postingRepository.List(ctx, common_repository.ListArgs[ledger_specification.PostingSpecification]{
+					Filters: []ledger_specification.PostingSpecification{ledger_specification.AccountIs(ledger_types.Assets(account.ID))},
+				})
+				must(t, err)
+
+				balance := int64(0)
+				for _, posting := range postings {
+					balance += posting.Amount.Amount
+				}
+
+				if balance != 0 {
+					t.Errorf("account %s is left with a balance of %d", account.ID, balance)
+				}
+			}
+		})
+	}
+}
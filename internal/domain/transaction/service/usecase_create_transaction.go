@@ -0,0 +1,139 @@
+package transaction_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_values "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/values"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type CreateTransactionParams struct {
+	AccountID  uuid.UUID
+	CategoryID uuid.UUID
+	// PayeeID, when not set, is resolved from the description.
+	PayeeID uuid.UUID
+	// ExternalID is set when the transaction comes from an imported statement.
+	ExternalID string
+	// SubscriptionID is set when the transaction charges a subscription fee.
+	SubscriptionID uuid.UUID
+	Description    string
+	Amount         int64
+	Currency       string
+	Direction      transaction_types.Direction
+	CreatedAt      time.Time
+	// ValueDate is set when the imported statement carries one besides the
+	// booking date in CreatedAt.
+	ValueDate time.Time
+	Splits    []SplitParams
+	Tags      []string
+}
+
+type CreateTransactionResult struct {
+	Transaction transaction_entity.Transaction
+	Payee       payee_entity.Payee
+	Splits      transaction_entity.Splits
+	Tags        tag_entity.Tags
+}
+
+func (s *TransactionServiceImpl) CreateTransaction(ctx context.Context, params *CreateTransactionParams) (*CreateTransactionResult, error) {
+	now := time.Now()
+	id := uuid.New()
+	transaction := transaction_entity.Transaction{
+		ID:             id,
+		JournalEntryID: id,
+		AccountID:      params.AccountID,
+		CategoryID:     params.CategoryID,
+		PayeeID:        params.PayeeID,
+		ExternalID:     params.ExternalID,
+		SubscriptionID: params.SubscriptionID,
+		Description:    params.Description,
+		Direction:      params.Direction,
+		ValueDate:      params.ValueDate,
+		CreatedAt:      params.CreatedAt,
+		UpdatedAt:      now,
+	}
+
+	if transaction.CreatedAt == common_values.NoTime {
+		transaction.CreatedAt = now
+	}
+
+	if !exists.String(transaction.Description) {
+		return nil, transaction_errors.ErrTransactionDescriptionEmpty
+	}
+
+	if params.Amount <= 0 {
+		return nil, transaction_errors.ErrTransactionAmountInvalid
+	}
+
+	if transaction.Direction == transaction_types.NoDirection {
+		return nil, transaction_errors.ErrTransactionDirectionInvalid
+	}
+
+	if transaction.Direction == transaction_types.Transfer {
+		return nil, transaction_errors.ErrTransactionTransferDirection
+	}
+
+	account, err := s.getAccount(ctx, transaction.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction.Amount, err = newAmount(params.Amount, params.Currency, account)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCategory(ctx, transaction.CategoryID); err != nil {
+		return nil, err
+	}
+
+	payee, err := s.getPayee(ctx, transaction.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payee == payee_entity.NoPayee {
+		payee, err = s.resolvePayee(ctx, transaction)
+		if err != nil {
+			return nil, err
+		}
+
+		transaction.PayeeID = payee.ID
+	}
+
+	splits, err := newSplits(transaction, params.Splits)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := tag_entity.Tags{}
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) (err error) {
+		if err := s.record(ctx, &transaction); err != nil {
+			return err
+		}
+
+		if err := s.setSplits(ctx, transaction, splits); err != nil {
+			return err
+		}
+
+		tags, err = s.setTags(ctx, transaction, params.Tags)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &CreateTransactionResult{
+		Transaction: transaction,
+		Payee:       payee,
+		Splits:      splits,
+		Tags:        tags,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package transaction_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_values "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/values"
+	account_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/entity"
+	account_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/errors"
+	account_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/specification"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type CreateTransferParams struct {
+	SourceAccountName      string
+	DestinationAccountName string
+	Description            string
+	Amount                 int64
+	Currency               string
+	CreatedAt              time.Time
+}
+
+type CreateTransferResult struct {
+	Transfer transaction_entity.Transfer
+}
+
+func (s *TransactionServiceImpl) CreateTransfer(ctx context.Context, params *CreateTransferParams) (*CreateTransferResult, error) {
+	if !exists.String(params.Description) {
+		return nil, transaction_errors.ErrTransactionDescriptionEmpty
+	}
+
+	if params.Amount <= 0 {
+		return nil, transaction_errors.ErrTransactionAmountInvalid
+	}
+
+	source, err := s.accountRepository.Get(ctx, account_specification.NameIs(params.SourceAccountName))
+	if err != nil {
+		return nil, err
+	}
+
+	if source == account_entity.NoAccount {
+		return nil, account_errors.ErrAccountNotFound
+	}
+
+	destination, err := s.accountRepository.Get(ctx, account_specification.NameIs(params.DestinationAccountName))
+	if err != nil {
+		return nil, err
+	}
+
+	if destination == account_entity.NoAccount {
+		return nil, account_errors.ErrAccountNotFound
+	}
+
+	if source.ID == destination.ID {
+		return nil, transaction_errors.ErrTransferSameAccount
+	}
+
+	amount, err := newAmount(params.Amount, params.Currency, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if destination.Currency != amount.Currency {
+		return nil, common_errors.ErrCurrencyMismatch
+	}
+
+	now := time.Now()
+	createdAt := params.CreatedAt
+	if createdAt == common_values.NoTime {
+		createdAt = now
+	}
+
+	transfer := transaction_entity.Transfer{
+		ID: uuid.New(),
+	}
+
+	transfer.Outgoing = transaction_entity.Transaction{
+		ID:             uuid.New(),
+		AccountID:      source.ID,
+		TransferID:     transfer.ID,
+		JournalEntryID: transfer.ID,
+		Description:    params.Description,
+		Amount:         amount.Neg(),
+		Direction:      transaction_types.Transfer,
+		CreatedAt:      createdAt,
+		UpdatedAt:      now,
+	}
+
+	transfer.Incoming = transaction_entity.Transaction{
+		ID:             uuid.New(),
+		AccountID:      destination.ID,
+		TransferID:     transfer.ID,
+		JournalEntryID: transfer.ID,
+		Description:    params.Description,
+		Amount:         amount,
+		Direction:      transaction_types.Transfer,
+		CreatedAt:      createdAt,
+		UpdatedAt:      now,
+	}
+
+	if err := s.record(ctx, &transfer.Outgoing, &transfer.Incoming); err != nil {
+		return nil, err
+	}
+
+	return &CreateTransferResult{
+		Transfer: transfer,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package transaction_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+)
+
+type DeleteTransactionParams struct {
+	ID uuid.UUID
+}
+
+type DeleteTransactionResult struct{}
+
+func (s *TransactionServiceImpl) DeleteTransaction(ctx context.Context, params *DeleteTransactionParams) (*DeleteTransactionResult, error) {
+	transaction, err := s.transactionRepository.Get(ctx, transaction_specification.WithID(params.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction == transaction_entity.NoTransaction {
+		return nil, transaction_errors.ErrTransactionNotFound
+	}
+
+	if err := s.unrecord(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	return &DeleteTransactionResult{}, nil
+}
@@ -0,0 +1,197 @@
+package transaction_service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/service"
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+)
+
+type ListDuplicatesParams struct {
+	Pagination common_service.PaginationParams
+}
+
+type ListDuplicatesResult struct {
+	Pagination   common_service.PaginationResult
+	Duplicates   transaction_entity.Duplicates
+	Transactions map[uuid.UUID]transaction_entity.Transaction
+	Payees       map[uuid.UUID]payee_entity.Payee
+}
+
+type ResolveDuplicatesParams struct {
+	KeepID uuid.UUID
+	// TransactionIDs are resolved against the kept transaction, every
+	// transaction flagged against it when empty.
+	TransactionIDs []uuid.UUID
+	Resolution     transaction_types.Resolution
+}
+
+type ResolveDuplicatesResult struct {
+	Transaction    transaction_entity.Transaction
+	Payee          payee_entity.Payee
+	Splits         transaction_entity.Splits
+	Tags           tag_entity.Tags
+	TransactionIDs []uuid.UUID
+}
+
+func (s *TransactionServiceImpl) ListDuplicates(ctx context.Context, params *ListDuplicatesParams) (*ListDuplicatesResult, error) {
+	filters := []transaction_specification.DuplicateSpecification{
+		transaction_specification.DismissedIs(false),
+	}
+
+	params.Pagination = params.Pagination.Normalize()
+
+	duplicates, err := s.duplicateRepository.List(ctx, common_repository.ListArgs[transaction_specification.DuplicateSpecification]{
+		Filters: filters,
+		Limit:   common_specification.WithLimit(params.Pagination.Limit()),
+		Offset:  common_specification.WithOffset(params.Pagination.Offset()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := s.duplicateRepository.Size(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []uuid.UUID{}
+	for _, duplicate := range duplicates {
+		ids = append(ids, duplicate.TransactionID, duplicate.DuplicateOfID)
+	}
+
+	transactions := map[uuid.UUID]transaction_entity.Transaction{}
+	payees := map[uuid.UUID]payee_entity.Payee{}
+	if len(ids) > 0 {
+		involved, err := s.transactionRepository.List(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+			Filters: []transaction_specification.TransactionSpecification{
+				transaction_specification.IDIn(ids...),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, transaction := range involved {
+			transactions[transaction.ID] = transaction
+		}
+
+		payees, err = s.getPayees(ctx, involved...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ListDuplicatesResult{
+		Pagination:   common_service.NewPaginationResult(params.Pagination, size),
+		Duplicates:   duplicates,
+		Transactions: transactions,
+		Payees:       payees,
+	}, nil
+}
+
+func (s *TransactionServiceImpl) ResolveDuplicates(ctx context.Context, params *ResolveDuplicatesParams) (*ResolveDuplicatesResult, error) {
+	if params.Resolution == transaction_types.NoResolution {
+		return nil, transaction_errors.ErrTransactionResolutionInvalid
+	}
+
+	keep, err := s.transactionRepository.Get(ctx, transaction_specification.WithID(params.KeepID))
+	if err != nil {
+		return nil, err
+	}
+
+	if keep == transaction_entity.NoTransaction {
+		return nil, transaction_errors.ErrTransactionNotFound
+	}
+
+	flags, err := s.duplicateRepository.List(ctx, common_repository.ListArgs[transaction_specification.DuplicateSpecification]{
+		Filters: []transaction_specification.DuplicateSpecification{
+			transaction_specification.Involves(keep.ID),
+			transaction_specification.DismissedIs(false),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	flagged := map[uuid.UUID]transaction_entity.Duplicate{}
+	for _, flag := range flags {
+		flagged[flag.Other(keep.ID)] = flag
+	}
+
+	ids := params.TransactionIDs
+	if len(ids) == 0 {
+		for _, flag := range flags {
+			ids = append(ids, flag.Other(keep.ID))
+		}
+	}
+
+	for _, id := range ids {
+		if _, ok := flagged[id]; !ok {
+			return nil, transaction_errors.ErrTransactionNotDuplicate.Format(id)
+		}
+	}
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		for _, id := range ids {
+			switch params.Resolution {
+			case transaction_types.Delete:
+				transaction, err := s.transactionRepository.Get(ctx, transaction_specification.WithID(id))
+				if err != nil {
+					return err
+				}
+
+				if transaction == transaction_entity.NoTransaction {
+					return transaction_errors.ErrTransactionNotFound
+				}
+
+				if err := s.unrecord(ctx, transaction); err != nil {
+					return err
+				}
+			case transaction_types.Dismiss:
+				flag := flagged[id]
+				flag.Dismissed = true
+
+				if err := s.duplicateRepository.Save(ctx, flag); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	payee, err := s.getPayee(ctx, keep.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := s.getSplits(ctx, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.getTags(ctx, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResolveDuplicatesResult{
+		Transaction:    keep,
+		Payee:          payee,
+		Splits:         splits[keep.ID],
+		Tags:           tags[keep.ID],
+		TransactionIDs: ids,
+	}, nil
+}
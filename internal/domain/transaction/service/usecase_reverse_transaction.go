@@ -0,0 +1,154 @@
+package transaction_service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_values "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/values"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type ReverseTransactionParams struct {
+	ID uuid.UUID
+	// Amount, when zero, reverses whatever is left of the transaction.
+	Amount int64
+	// Description defaults to the one of the reversed transaction.
+	Description string
+	CreatedAt   time.Time
+}
+
+type ReverseTransactionResult struct {
+	Reversal    transaction_entity.Transaction
+	Transaction transaction_entity.Transaction
+	Payee       payee_entity.Payee
+	// Splits and Tags are the ones of the reversed transaction, as the
+	// reversal has none.
+	Splits transaction_entity.Splits
+	Tags   tag_entity.Tags
+	// Reversals holds every reversal of the transaction, the new one included.
+	Reversals transaction_entity.Transactions
+}
+
+// ReverseTransaction records a refund or return of the transaction as a new
+// transaction in the opposite direction, keeping the original untouched. A
+// charged subscription stays linked, so its spend is net of refunds.
+func (s *TransactionServiceImpl) ReverseTransaction(ctx context.Context, params *ReverseTransactionParams) (*ReverseTransactionResult, error) {
+	if params.Amount < 0 {
+		return nil, transaction_errors.ErrTransactionAmountInvalid
+	}
+
+	var (
+		transaction transaction_entity.Transaction
+		reversal    transaction_entity.Transaction
+		reversals   transaction_entity.Transactions
+	)
+
+	// The original stays locked until the reversal is recorded, so concurrent
+	// reversals cannot exceed it together.
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		transaction, err = s.lockTransaction(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+
+		if transaction.IsTransfer() {
+			return transaction_errors.ErrTransactionReversalTransfer
+		}
+
+		if transaction.IsReversal() {
+			return transaction_errors.ErrTransactionReversalOfReversal
+		}
+
+		reversalsByID, err := s.getReversals(ctx, transaction)
+		if err != nil {
+			return err
+		}
+
+		reversals = reversalsByID[transaction.ID]
+
+		remaining := transaction.NetAmount(reversals)
+		if !remaining.IsPositive() {
+			return transaction_errors.ErrTransactionReversalExceeded.Format(remaining.Neg())
+		}
+
+		now := time.Now()
+		id := uuid.New()
+		reversal = transaction_entity.Transaction{
+			ID:             id,
+			JournalEntryID: id,
+			AccountID:      transaction.AccountID,
+			CategoryID:     transaction.CategoryID,
+			PayeeID:        transaction.PayeeID,
+			SubscriptionID: transaction.SubscriptionID,
+			ReversalOfID:   transaction.ID,
+			Description:    params.Description,
+			Amount:         remaining,
+			Direction:      transaction_types.Income,
+			CreatedAt:      params.CreatedAt,
+			UpdatedAt:      now,
+		}
+
+		if transaction.Direction == transaction_types.Income {
+			reversal.Direction = transaction_types.Expense
+		}
+
+		if !exists.String(reversal.Description) {
+			reversal.Description = transaction.Description
+		}
+
+		if reversal.CreatedAt == common_values.NoTime {
+			reversal.CreatedAt = now
+		}
+
+		if params.Amount != 0 {
+			reversal.Amount.Amount = params.Amount
+		}
+
+		if err := s.checkReversals(ctx, transaction, append(reversals, reversal)); err != nil {
+			return err
+		}
+
+		if err := s.record(ctx, &reversal); err != nil {
+			return err
+		}
+
+		// The rules may have changed the reversal while recording it.
+		reversals = append(reversals, reversal)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	payee, err := s.getPayee(ctx, reversal.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := s.getSplits(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.getTags(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReverseTransactionResult{
+		Reversal:    reversal,
+		Transaction: transaction,
+		Payee:       payee,
+		Splits:      splits[transaction.ID],
+		Tags:        tags[transaction.ID],
+		Reversals:   reversals,
+	}, nil
+}
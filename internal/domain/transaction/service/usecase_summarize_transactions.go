@@ -0,0 +1,94 @@
+package transaction_service
+
+import (
+	"context"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/repository"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	rate_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/entity"
+	transaction_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/specification"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type SummarizeTransactionsParams struct {
+	FilterTransactionsParams
+	// ConvertTo, when set, converts every amount into the currency using the
+	// rate effective on the transaction date before it is totalled.
+	ConvertTo string
+}
+
+// SummarizeTransactionsResult totals the transactions in their common currency.
+// Summarizing transactions in different currencies fails with
+// ErrCurrencyMismatch; filter by currency or convert them to summarize.
+// Reversals are taken off the total of the transactions they reverse, so a
+// refunded expense lowers the expense instead of adding to the income.
+type SummarizeTransactionsResult struct {
+	Income  common_types.Money
+	Expense common_types.Money
+	Net     common_types.Money
+}
+
+func (s *TransactionServiceImpl) SummarizeTransactions(ctx context.Context, params *SummarizeTransactionsParams) (*SummarizeTransactionsResult, error) {
+	var rates rate_entity.Rates
+	convertTo := common_types.NormalizeCurrency(params.ConvertTo)
+	if exists.String(params.ConvertTo) {
+		if !common_types.IsCurrency(convertTo) {
+			return nil, common_errors.ErrCurrencyInvalid
+		}
+
+		var err error
+		rates, err = s.getRates(ctx, convertTo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	iterator, err := s.transactionRepository.Each(ctx, common_repository.ListArgs[transaction_specification.TransactionSpecification]{
+		Filters: params.Specifications(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SummarizeTransactionsResult{}
+	for iterator.Next() {
+		transaction, err := iterator.Current()
+		if err != nil {
+			return nil, err
+		}
+
+		if transaction.IsIgnored() {
+			continue
+		}
+
+		if exists.String(params.ConvertTo) {
+			transaction.Amount, err = convertAmount(rates, convertTo, transaction)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch {
+		case transaction.IsReversal() && transaction.Direction == transaction_types.Income:
+			result.Expense, err = result.Expense.Sub(transaction.Amount)
+		case transaction.IsReversal() && transaction.Direction == transaction_types.Expense:
+			result.Income, err = result.Income.Sub(transaction.Amount)
+		case transaction.Direction == transaction_types.Income:
+			result.Income, err = result.Income.Add(transaction.Amount)
+		case transaction.Direction == transaction_types.Expense:
+			result.Expense, err = result.Expense.Add(transaction.Amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Net, err = result.Income.Sub(result.Expense)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,237 @@
+package transaction_service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	common_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/errors"
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
+	payee_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/entity"
+	tag_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/entity"
+	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_errors "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/errors"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
+	"github.com/fikrirnurhidayat/banda-lumaksa/pkg/exists"
+)
+
+type UpdateTransactionParams struct {
+	ID          uuid.UUID
+	AccountID   common_types.Maybe[uuid.UUID]
+	CategoryID  common_types.Maybe[uuid.UUID]
+	PayeeID     common_types.Maybe[uuid.UUID]
+	Description common_types.Maybe[string]
+	Amount      common_types.Maybe[int64]
+	Direction   common_types.Maybe[transaction_types.Direction]
+	// Label and Ignored are set by the user, over whatever the rules set.
+	Label     common_types.Maybe[string]
+	Ignored   common_types.Maybe[bool]
+	CreatedAt common_types.Maybe[time.Time]
+	Splits    common_types.Maybe[[]SplitParams]
+	Tags      common_types.Maybe[[]string]
+}
+
+type UpdateTransactionResult struct {
+	Transaction transaction_entity.Transaction
+	Payee       payee_entity.Payee
+	Splits      transaction_entity.Splits
+	Tags        tag_entity.Tags
+	Reversals   transaction_entity.Transactions
+}
+
+// UpdateTransaction keeps the transaction locked from reading its reversals
+// until it is recorded again, so no reversal is recorded against the amount
+// or direction it had before.
+func (s *TransactionServiceImpl) UpdateTransaction(ctx context.Context, params *UpdateTransactionParams) (*UpdateTransactionResult, error) {
+	var result *UpdateTransactionResult
+
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		transaction, err := s.lockTransaction(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+
+		result, err = s.updateTransaction(ctx, transaction, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *TransactionServiceImpl) updateTransaction(ctx context.Context, transaction transaction_entity.Transaction, params *UpdateTransactionParams) (*UpdateTransactionResult, error) {
+	if transaction.IsReconciled() {
+		return nil, transaction_errors.ErrTransactionReconciled
+	}
+
+	reversalsByID, err := s.getReversals(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	reversals := reversalsByID[transaction.ID]
+
+	if params.AccountID.Present && params.AccountID.Value != transaction.AccountID {
+		account, err := s.getAccount(ctx, params.AccountID.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if account.Currency != transaction.Amount.Currency {
+			return nil, common_errors.ErrCurrencyMismatch
+		}
+
+		transaction.AccountID = params.AccountID.Value
+	}
+
+	if params.CategoryID.Present && params.CategoryID.Value != transaction.CategoryID {
+		if err := s.checkCategory(ctx, params.CategoryID.Value); err != nil {
+			return nil, err
+		}
+
+		transaction.CategoryID = params.CategoryID.Value
+	}
+
+	if params.PayeeID.Present {
+		if transaction.IsTransfer() && params.PayeeID.Value != uuid.Nil {
+			return nil, transaction_errors.ErrTransactionTransferPayee
+		}
+
+		transaction.PayeeID = params.PayeeID.Value
+	}
+
+	payee, err := s.getPayee(ctx, transaction.PayeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Description.Present {
+		if !exists.String(params.Description.Value) {
+			return nil, transaction_errors.ErrTransactionDescriptionEmpty
+		}
+
+		transaction.Description = params.Description.Value
+	}
+
+	if params.Label.Present {
+		transaction.Label = strings.TrimSpace(params.Label.Value)
+	}
+
+	if params.Ignored.Present {
+		transaction.Ignored = params.Ignored.Value
+	}
+
+	if params.Amount.Present {
+		if params.Amount.Value <= 0 {
+			return nil, transaction_errors.ErrTransactionAmountInvalid
+		}
+
+		if transaction.Amount.IsNegative() {
+			transaction.Amount.Amount = -params.Amount.Value
+		} else {
+			transaction.Amount.Amount = params.Amount.Value
+		}
+	}
+
+	if params.Direction.Present && params.Direction.Value != transaction.Direction {
+		if params.Direction.Value == transaction_types.NoDirection {
+			return nil, transaction_errors.ErrTransactionDirectionInvalid
+		}
+
+		if params.Direction.Value == transaction_types.Transfer || transaction.IsTransfer() {
+			return nil, transaction_errors.ErrTransactionTransferDirection
+		}
+
+		if transaction.IsReversal() || len(reversals) > 0 {
+			return nil, transaction_errors.ErrTransactionReversed
+		}
+
+		transaction.Direction = params.Direction.Value
+	}
+
+	if params.Amount.Present {
+		if err := s.checkReversals(ctx, transaction, reversals); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.CreatedAt.Present && exists.Date(params.CreatedAt.Value) {
+		transaction.CreatedAt = params.CreatedAt.Value
+	}
+
+	transaction.UpdatedAt = time.Now()
+
+	splits := transaction_entity.Splits{}
+	if params.Splits.Present {
+		splits, err = newSplits(transaction, params.Splits.Value)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		splitsByID, err := s.getSplits(ctx, transaction)
+		if err != nil {
+			return nil, err
+		}
+
+		splits = splitsByID[transaction.ID]
+		if !splits.IsBalanced(transaction) {
+			return nil, transaction_errors.ErrTransactionSplitsUnbalanced
+		}
+	}
+
+	transactions := []*transaction_entity.Transaction{&transaction}
+
+	if transaction.IsTransfer() {
+		counterpart, err := s.getCounterpart(ctx, transaction)
+		if err != nil {
+			return nil, err
+		}
+
+		if counterpart.AccountID == transaction.AccountID {
+			return nil, transaction_errors.ErrTransferSameAccount
+		}
+
+		counterpart.Description = transaction.Description
+		counterpart.Amount = transaction.Amount.Neg()
+		counterpart.CreatedAt = transaction.CreatedAt
+		counterpart.UpdatedAt = transaction.UpdatedAt
+
+		transactions = append(transactions, &counterpart)
+	}
+
+	tags := tag_entity.Tags{}
+	if err := s.transactionManager.Execute(ctx, func(ctx context.Context) error {
+		if err := s.record(ctx, transactions...); err != nil {
+			return err
+		}
+
+		if params.Splits.Present {
+			if err := s.setSplits(ctx, transaction, splits); err != nil {
+				return err
+			}
+		}
+
+		if params.Tags.Present {
+			setTags, err := s.setTags(ctx, transaction, params.Tags.Value)
+			tags = setTags
+			return err
+		}
+
+		tagsByID, err := s.getTags(ctx, transaction)
+		tags = tagsByID[transaction.ID]
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &UpdateTransactionResult{
+		Transaction: transaction,
+		Payee:       payee,
+		Splits:      splits,
+		Tags:        tags,
+		Reversals:   reversals,
+	}, nil
+}
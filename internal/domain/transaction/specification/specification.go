@@ -1,11 +1,15 @@
 package transaction
 
 import (
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
+	common_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/types"
 	transaction_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/entity"
+	transaction_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/types"
 )
 
 type TransactionSpecification interface {
@@ -26,6 +30,47 @@ func DescriptionLike(like string) TransactionSpecification {
 	}
 }
 
+// SearchSpecification matches transactions by full-text search over the
+// description and label, with the web search syntax: quoted phrases, "or"
+// between alternatives and a leading minus to exclude a word. In memory the
+// words are only looked up as substrings and phrases are not kept in order.
+type SearchSpecification struct {
+	Query string
+}
+
+func (spec SearchSpecification) Call(transaction transaction_entity.Transaction) bool {
+	text := strings.ToLower(transaction.Description + " " + transaction.EffectiveLabel())
+	words := strings.Fields(strings.ToLower(strings.ReplaceAll(spec.Query, `"`, " ")))
+
+	// The words between two "or" must all match, like the AND binding tighter
+	// than the OR in the query postgres builds.
+	matched := true
+	for _, word := range words {
+		switch {
+		case word == "or":
+			if matched {
+				return true
+			}
+
+			matched = true
+		case strings.HasPrefix(word, "-"):
+			if excluded := strings.TrimPrefix(word, "-"); excluded != "" && strings.Contains(text, excluded) {
+				matched = false
+			}
+		case !strings.Contains(text, word):
+			matched = false
+		}
+	}
+
+	return matched
+}
+
+func Search(query string) TransactionSpecification {
+	return SearchSpecification{
+		Query: query,
+	}
+}
+
 type WithIDSpecification struct {
 	ID uuid.UUID
 }
@@ -39,3 +84,462 @@ func WithID(id uuid.UUID) TransactionSpecification {
 		ID: id,
 	}
 }
+
+type DirectionIsSpecification struct {
+	Direction transaction_types.Direction
+}
+
+func (spec DirectionIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return transaction.Direction == spec.Direction
+}
+
+func DirectionIs(direction transaction_types.Direction) TransactionSpecification {
+	return DirectionIsSpecification{
+		Direction: direction,
+	}
+}
+
+type AccountIsSpecification struct {
+	AccountID uuid.UUID
+}
+
+func (spec AccountIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return transaction.AccountID == spec.AccountID
+}
+
+func AccountIs(accountID uuid.UUID) TransactionSpecification {
+	return AccountIsSpecification{
+		AccountID: accountID,
+	}
+}
+
+type CreatedBeforeSpecification struct {
+	Time time.Time
+}
+
+func (spec CreatedBeforeSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return !transaction.CreatedAt.After(spec.Time)
+}
+
+func CreatedBefore(t time.Time) TransactionSpecification {
+	return CreatedBeforeSpecification{
+		Time: t,
+	}
+}
+
+type CreatedAfterSpecification struct {
+	Time time.Time
+}
+
+func (spec CreatedAfterSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return !transaction.CreatedAt.Before(spec.Time)
+}
+
+func CreatedAfter(t time.Time) TransactionSpecification {
+	return CreatedAfterSpecification{
+		Time: t,
+	}
+}
+
+// UpdatedBetweenSpecification matches transactions last updated within the
+// bounds, both included. A zero bound leaves that side open.
+type UpdatedBetweenSpecification struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (spec UpdatedBetweenSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return between(transaction.UpdatedAt, spec.Start, spec.End)
+}
+
+func UpdatedBetween(start time.Time, end time.Time) TransactionSpecification {
+	return UpdatedBetweenSpecification{
+		Start: start,
+		End:   end,
+	}
+}
+
+// CreatedBetweenSpecification matches transactions created within the bounds,
+// both included. A zero bound leaves that side open.
+type CreatedBetweenSpecification struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (spec CreatedBetweenSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return between(transaction.CreatedAt, spec.Start, spec.End)
+}
+
+func CreatedBetween(start time.Time, end time.Time) TransactionSpecification {
+	return CreatedBetweenSpecification{
+		Start: start,
+		End:   end,
+	}
+}
+
+func between(t time.Time, start time.Time, end time.Time) bool {
+	return (start.IsZero() || !t.Before(start)) && (end.IsZero() || !t.After(end))
+}
+
+// AmountBetweenSpecification matches transactions whose absolute amount, so
+// regardless of the direction or the sign of a transfer leg, is within the
+// bounds given, both included. Amounts are in minor units of the currency of
+// each transaction and the currency is not compared, so combine it with
+// CurrencyIs to compare like with like.
+type AmountBetweenSpecification struct {
+	Min common_types.Maybe[int64]
+	Max common_types.Maybe[int64]
+}
+
+func (spec AmountBetweenSpecification) Call(transaction transaction_entity.Transaction) bool {
+	amount := transaction.Amount.Abs().Amount
+	return (!spec.Min.Present || amount >= spec.Min.Value) && (!spec.Max.Present || amount <= spec.Max.Value)
+}
+
+func AmountBetween(min common_types.Maybe[int64], max common_types.Maybe[int64]) TransactionSpecification {
+	return AmountBetweenSpecification{
+		Min: min,
+		Max: max,
+	}
+}
+
+// ChargedBySubscriptionSpecification matches the transactions charging the fee
+// of any of the subscriptions.
+type ChargedBySubscriptionSpecification struct {
+	SubscriptionIDs []uuid.UUID
+}
+
+func (spec ChargedBySubscriptionSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return transaction.IsCharge() && slices.Contains(spec.SubscriptionIDs, transaction.SubscriptionID)
+}
+
+func ChargedBySubscription(subscriptionIDs ...uuid.UUID) TransactionSpecification {
+	return ChargedBySubscriptionSpecification{
+		SubscriptionIDs: subscriptionIDs,
+	}
+}
+
+// ReversalOfSpecification matches the reversals of any of the transactions.
+type ReversalOfSpecification struct {
+	TransactionIDs []uuid.UUID
+}
+
+func (spec ReversalOfSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return transaction.IsReversal() && slices.Contains(spec.TransactionIDs, transaction.ReversalOfID)
+}
+
+func ReversalOf(transactionIDs ...uuid.UUID) TransactionSpecification {
+	return ReversalOfSpecification{
+		TransactionIDs: transactionIDs,
+	}
+}
+
+// AmountIsSpecification matches the signed amount in the same currency.
+type AmountIsSpecification struct {
+	Amount common_types.Money
+}
+
+func (spec AmountIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return transaction.Amount == spec.Amount
+}
+
+func AmountIs(amount common_types.Money) TransactionSpecification {
+	return AmountIsSpecification{
+		Amount: amount,
+	}
+}
+
+type WithoutIDSpecification struct {
+	ID uuid.UUID
+}
+
+func (spec WithoutIDSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return spec.ID != transaction.ID
+}
+
+func WithoutID(id uuid.UUID) TransactionSpecification {
+	return WithoutIDSpecification{
+		ID: id,
+	}
+}
+
+type ExternalIDInSpecification struct {
+	ExternalIDs []string
+}
+
+func (spec ExternalIDInSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return slices.Contains(spec.ExternalIDs, transaction.ExternalID)
+}
+
+func ExternalIDIn(externalIDs ...string) TransactionSpecification {
+	return ExternalIDInSpecification{
+		ExternalIDs: externalIDs,
+	}
+}
+
+type ClearedIsSpecification struct {
+	Cleared bool
+}
+
+func (spec ClearedIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return spec.Cleared == transaction.Cleared
+}
+
+func ClearedIs(cleared bool) TransactionSpecification {
+	return ClearedIsSpecification{
+		Cleared: cleared,
+	}
+}
+
+type ReconciledIsSpecification struct {
+	Reconciled bool
+}
+
+func (spec ReconciledIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return spec.Reconciled == transaction.IsReconciled()
+}
+
+func ReconciledIs(reconciled bool) TransactionSpecification {
+	return ReconciledIsSpecification{
+		Reconciled: reconciled,
+	}
+}
+
+type ReversalIsSpecification struct {
+	Reversal bool
+}
+
+func (spec ReversalIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return spec.Reversal == transaction.IsReversal()
+}
+
+func ReversalIs(reversal bool) TransactionSpecification {
+	return ReversalIsSpecification{
+		Reversal: reversal,
+	}
+}
+
+type ReconciliationIsSpecification struct {
+	ReconciliationID uuid.UUID
+}
+
+func (spec ReconciliationIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return spec.ReconciliationID == transaction.ReconciliationID
+}
+
+func ReconciliationIs(reconciliationID uuid.UUID) TransactionSpecification {
+	return ReconciliationIsSpecification{
+		ReconciliationID: reconciliationID,
+	}
+}
+
+type IDInSpecification struct {
+	IDs []uuid.UUID
+}
+
+func (spec IDInSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return slices.Contains(spec.IDs, transaction.ID)
+}
+
+func IDIn(ids ...uuid.UUID) TransactionSpecification {
+	return IDInSpecification{
+		IDs: ids,
+	}
+}
+
+type TransferIsSpecification struct {
+	TransferID uuid.UUID
+}
+
+func (spec TransferIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return transaction.TransferID == spec.TransferID
+}
+
+func TransferIs(transferID uuid.UUID) TransactionSpecification {
+	return TransferIsSpecification{
+		TransferID: transferID,
+	}
+}
+
+// CategoryIsSpecification matches transactions in the category. Repositories
+// that know the category tree also match its descendants.
+type CategoryIsSpecification struct {
+	CategoryID uuid.UUID
+}
+
+func (spec CategoryIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return transaction.CategoryID == spec.CategoryID
+}
+
+func CategoryIs(categoryID uuid.UUID) TransactionSpecification {
+	return CategoryIsSpecification{
+		CategoryID: categoryID,
+	}
+}
+
+type PayeeIsSpecification struct {
+	PayeeID uuid.UUID
+}
+
+func (spec PayeeIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return transaction.PayeeID == spec.PayeeID
+}
+
+func PayeeIs(payeeID uuid.UUID) TransactionSpecification {
+	return PayeeIsSpecification{
+		PayeeID: payeeID,
+	}
+}
+
+// HasAnyTagSpecification matches transactions tagged with at least one of the
+// names. Tags live outside the entity, so only repositories evaluate it.
+type HasAnyTagSpecification struct {
+	Names []string
+}
+
+func (spec HasAnyTagSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return false
+}
+
+func HasAnyTag(names ...string) TransactionSpecification {
+	return HasAnyTagSpecification{
+		Names: names,
+	}
+}
+
+// HasAllTagsSpecification matches transactions tagged with every one of the
+// names. Tags live outside the entity, so only repositories evaluate it.
+type HasAllTagsSpecification struct {
+	Names []string
+}
+
+func (spec HasAllTagsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return false
+}
+
+func HasAllTags(names ...string) TransactionSpecification {
+	return HasAllTagsSpecification{
+		Names: names,
+	}
+}
+
+type CurrencyIsSpecification struct {
+	Currency string
+}
+
+func (spec CurrencyIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return transaction.Amount.Currency == spec.Currency
+}
+
+func CurrencyIs(currency string) TransactionSpecification {
+	return CurrencyIsSpecification{
+		Currency: currency,
+	}
+}
+
+// SplitLabelIsSpecification matches transactions with at least one split line
+// labeled so. Splits live outside the entity, so only repositories evaluate it.
+type SplitLabelIsSpecification struct {
+	Label string
+}
+
+func (spec SplitLabelIsSpecification) Call(transaction transaction_entity.Transaction) bool {
+	return false
+}
+
+func SplitLabelIs(label string) TransactionSpecification {
+	return SplitLabelIsSpecification{
+		Label: label,
+	}
+}
+
+type SplitSpecification interface {
+	Call(split transaction_entity.Split) bool
+}
+
+type TransactionIsSpecification struct {
+	TransactionID uuid.UUID
+}
+
+func (spec TransactionIsSpecification) Call(split transaction_entity.Split) bool {
+	return split.TransactionID == spec.TransactionID
+}
+
+func TransactionIs(transactionID uuid.UUID) SplitSpecification {
+	return TransactionIsSpecification{
+		TransactionID: transactionID,
+	}
+}
+
+type TransactionInSpecification struct {
+	TransactionIDs []uuid.UUID
+}
+
+func (spec TransactionInSpecification) Call(split transaction_entity.Split) bool {
+	for _, id := range spec.TransactionIDs {
+		if split.TransactionID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TransactionIn(transactionIDs ...uuid.UUID) SplitSpecification {
+	return TransactionInSpecification{
+		TransactionIDs: transactionIDs,
+	}
+}
+
+type DuplicateSpecification interface {
+	Call(duplicate transaction_entity.Duplicate) bool
+}
+
+// InvolvesSpecification matches duplicate flags raised on the transaction,
+// whichever side of the pair it is on.
+type InvolvesSpecification struct {
+	TransactionID uuid.UUID
+}
+
+func (spec InvolvesSpecification) Call(duplicate transaction_entity.Duplicate) bool {
+	return duplicate.TransactionID == spec.TransactionID || duplicate.DuplicateOfID == spec.TransactionID
+}
+
+func Involves(transactionID uuid.UUID) DuplicateSpecification {
+	return InvolvesSpecification{
+		TransactionID: transactionID,
+	}
+}
+
+// PairIsSpecification matches the flag raised on both transactions, in either
+// order.
+type PairIsSpecification struct {
+	TransactionID uuid.UUID
+	OtherID       uuid.UUID
+}
+
+func (spec PairIsSpecification) Call(duplicate transaction_entity.Duplicate) bool {
+	return (duplicate.TransactionID == spec.TransactionID && duplicate.DuplicateOfID == spec.OtherID) ||
+		(duplicate.TransactionID == spec.OtherID && duplicate.DuplicateOfID == spec.TransactionID)
+}
+
+func PairIs(transactionID uuid.UUID, otherID uuid.UUID) DuplicateSpecification {
+	return PairIsSpecification{
+		TransactionID: transactionID,
+		OtherID:       otherID,
+	}
+}
+
+type DismissedIsSpecification struct {
+	Dismissed bool
+}
+
+func (spec DismissedIsSpecification) Call(duplicate transaction_entity.Duplicate) bool {
+	return duplicate.Dismissed == spec.Dismissed
+}
+
+func DismissedIs(dismissed bool) DuplicateSpecification {
+	return DismissedIsSpecification{
+		Dismissed: dismissed,
+	}
+}
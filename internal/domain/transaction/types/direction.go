@@ -0,0 +1,52 @@
+package transaction_types
+
+import "encoding/json"
+
+type Direction int
+
+const (
+	Income Direction = iota
+	Expense
+	Transfer
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Income:
+		return "Income"
+	case Expense:
+		return "Expense"
+	case Transfer:
+		return "Transfer"
+	default:
+		return ""
+	}
+}
+
+func (d *Direction) UnmarshalJSON(b []byte) error {
+	var val string
+	if err := json.Unmarshal(b, &val); err != nil {
+		return err
+	}
+	*d = GetDirection(val)
+	return nil
+}
+
+func (d *Direction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func GetDirection(str string) Direction {
+	switch str {
+	case "Income":
+		return Income
+	case "Expense":
+		return Expense
+	case "Transfer":
+		return Transfer
+	default:
+		return NoDirection
+	}
+}
+
+var NoDirection Direction = -1
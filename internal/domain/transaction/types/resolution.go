@@ -0,0 +1,49 @@
+package transaction_types
+
+import "encoding/json"
+
+// Resolution is what happens to the transactions flagged as duplicates of the
+// one kept.
+type Resolution int
+
+const (
+	Delete Resolution = iota
+	Dismiss
+)
+
+func (r Resolution) String() string {
+	switch r {
+	case Delete:
+		return "Delete"
+	case Dismiss:
+		return "Dismiss"
+	default:
+		return ""
+	}
+}
+
+func (r *Resolution) UnmarshalJSON(b []byte) error {
+	var val string
+	if err := json.Unmarshal(b, &val); err != nil {
+		return err
+	}
+	*r = GetResolution(val)
+	return nil
+}
+
+func (r *Resolution) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func GetResolution(str string) Resolution {
+	switch str {
+	case "Delete":
+		return Delete
+	case "Dismiss":
+		return Dismiss
+	default:
+		return NoResolution
+	}
+}
+
+var NoResolution Resolution = -1
@@ -0,0 +1,59 @@
+package banda_command
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/config"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/config/version"
+	http_server "github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/http/server"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	export_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/service"
+	export_types "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/types"
+)
+
+var ExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export transactions as a plain-text accounting journal.",
+	Long:  `Export every transaction as a ledger, hledger or beancount journal. Subscription charges are booked to Expenses:Subscriptions:<name>, other income and expenses to the default accounts set under export.accounts in the config.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+
+		config.Init()
+		log := logger.New(version.Version, version.Build)
+
+		srv, err := http_server.New(log)
+		if err != nil {
+			log.Fatal("export/FAILURE", logger.String("error", err.Error()))
+		}
+
+		var writer io.Writer = os.Stdout
+		if output != "" {
+			file, err := os.Create(output)
+			if err != nil {
+				log.Fatal("export/FAILURE", logger.String("error", err.Error()))
+			}
+			defer file.Close()
+
+			writer = file
+		}
+
+		if _, err := srv.Dependency.ExportService.ExportJournal(context.Background(), &export_service.ExportJournalParams{
+			Format: export_types.GetFormat(format),
+			Writer: writer,
+		}); err != nil {
+			log.Fatal("export/EXPORT_FAILURE", logger.String("error", err.Error()))
+		}
+	},
+}
+
+func init() {
+	ExportCmd.Flags().String("format", "ledger", "Journal syntax: ledger, hledger or beancount.")
+	ExportCmd.Flags().StringP("output", "o", "", "File to write the journal to, standard output when empty.")
+}
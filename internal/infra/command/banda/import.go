@@ -0,0 +1,292 @@
+package banda_command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/config"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/config/version"
+	http_server "github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/http/server"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	importer_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/entity"
+	importer_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/service"
+)
+
+var ImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import bank statements.",
+	Long:  `Import bank statements.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var ImportCSVCmd = &cobra.Command{
+	Use:   "csv <file>",
+	Short: "Import transactions from a bank CSV export.",
+	Long:  `Import transactions from a bank CSV export, mapped onto transactions by a saved import profile. Use --preview to check the parsed rows first, nothing is saved then. Either every row is imported or none.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		profile, _ := cmd.Flags().GetString("profile")
+		account, _ := cmd.Flags().GetString("account")
+		preview, _ := cmd.Flags().GetBool("preview")
+
+		config.Init()
+		log := logger.New(version.Version, version.Build)
+
+		srv, err := http_server.New(log)
+		if err != nil {
+			log.Fatal("import/FAILURE", logger.String("error", err.Error()))
+		}
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			log.Fatal("import/FAILURE", logger.String("error", err.Error()))
+		}
+		defer file.Close()
+
+		if preview {
+			result, err := srv.Dependency.ImporterService.PreviewCSV(context.Background(), &importer_service.PreviewCSVParams{
+				ProfileName: profile,
+				AccountName: account,
+				CSV:         file,
+			})
+			if err != nil {
+				log.Fatal("import/PREVIEW_FAILURE", logger.String("error", err.Error()))
+			}
+
+			printPreview(result.Rows, nil, result.Errors, result.Account.Currency)
+			return
+		}
+
+		result, err := srv.Dependency.ImporterService.ImportCSV(context.Background(), &importer_service.ImportCSVParams{
+			ProfileName: profile,
+			AccountName: account,
+			CSV:         file,
+		})
+		if err != nil {
+			log.Fatal("import/IMPORT_FAILURE", logger.String("error", err.Error()))
+		}
+
+		fmt.Printf("Imported %d transactions.\n", len(result.TransactionIDs))
+	},
+}
+
+var ImportOFXCmd = &cobra.Command{
+	Use:   "ofx <file>",
+	Short: "Import transactions from an OFX download.",
+	Long:  `Import transactions from an OFX 1.x or 2.x download. Entries whose FITID was imported into the account before are skipped, so overlapping downloads can be imported safely. Use --preview to check the parsed entries first, nothing is saved then.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		account, _ := cmd.Flags().GetString("account")
+		preview, _ := cmd.Flags().GetBool("preview")
+
+		config.Init()
+		log := logger.New(version.Version, version.Build)
+
+		srv, err := http_server.New(log)
+		if err != nil {
+			log.Fatal("import/FAILURE", logger.String("error", err.Error()))
+		}
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			log.Fatal("import/FAILURE", logger.String("error", err.Error()))
+		}
+		defer file.Close()
+
+		if preview {
+			result, err := srv.Dependency.ImporterService.PreviewOFX(context.Background(), &importer_service.PreviewOFXParams{
+				AccountName: account,
+				OFX:         file,
+			})
+			if err != nil {
+				log.Fatal("import/PREVIEW_FAILURE", logger.String("error", err.Error()))
+			}
+
+			printPreview(result.Rows, result.Skipped, result.Errors, result.Account.Currency)
+			return
+		}
+
+		result, err := srv.Dependency.ImporterService.ImportOFX(context.Background(), &importer_service.ImportOFXParams{
+			AccountName: account,
+			OFX:         file,
+		})
+		if err != nil {
+			log.Fatal("import/IMPORT_FAILURE", logger.String("error", err.Error()))
+		}
+
+		fmt.Printf("Imported %d transactions, skipped %d already imported.\n", len(result.TransactionIDs), result.Skipped)
+	},
+}
+
+var ImportCamtCmd = &cobra.Command{
+	Use:   "camt <file>",
+	Short: "Import transactions from an ISO 20022 camt.053 or camt.052 statement.",
+	Long:  `Import transactions from an ISO 20022 camt.053 end-of-day statement or camt.052 intraday report. Entries whose reference was imported into the account before are skipped. Entries that cannot be imported, such as pending ones, are reported and do not stop the others. Use --preview to check the parsed entries first, nothing is saved then.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		account, _ := cmd.Flags().GetString("account")
+		preview, _ := cmd.Flags().GetBool("preview")
+
+		config.Init()
+		log := logger.New(version.Version, version.Build)
+
+		srv, err := http_server.New(log)
+		if err != nil {
+			log.Fatal("import/FAILURE", logger.String("error", err.Error()))
+		}
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			log.Fatal("import/FAILURE", logger.String("error", err.Error()))
+		}
+		defer file.Close()
+
+		if preview {
+			result, err := srv.Dependency.ImporterService.PreviewCamt(context.Background(), &importer_service.PreviewCamtParams{
+				AccountName: account,
+				Camt:        file,
+			})
+			if err != nil {
+				log.Fatal("import/PREVIEW_FAILURE", logger.String("error", err.Error()))
+			}
+
+			printPreview(result.Rows, result.Skipped, result.Errors, result.Account.Currency)
+			return
+		}
+
+		result, err := srv.Dependency.ImporterService.ImportCamt(context.Background(), &importer_service.ImportCamtParams{
+			AccountName: account,
+			Camt:        file,
+		})
+		if err != nil {
+			log.Fatal("import/IMPORT_FAILURE", logger.String("error", err.Error()))
+		}
+
+		for _, rowError := range result.Errors {
+			fmt.Printf("Line %d: %s\n", rowError.Line, rowError.Reason)
+		}
+
+		fmt.Printf("Imported %d transactions, skipped %d already imported, %d entries failed.\n", len(result.TransactionIDs), result.Skipped, len(result.Errors))
+	},
+}
+
+var ImportJournalCmd = &cobra.Command{
+	Use:   "journal <file>",
+	Short: "Import transactions from a ledger, hledger or beancount journal.",
+	Long:  `Import the transactions of a ledger, hledger or beancount journal. A posting belongs to an account when the last component of its account name is the account name, so Assets:Bank:BCA-Main posts to the account "bca main". Entries posting to two accounts are imported as transfers. Directives other than transactions are reported and left out. Either every entry is imported or none. Use --preview to check the resolved entries first, nothing is saved then.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		preview, _ := cmd.Flags().GetBool("preview")
+
+		config.Init()
+		log := logger.New(version.Version, version.Build)
+
+		srv, err := http_server.New(log)
+		if err != nil {
+			log.Fatal("import/FAILURE", logger.String("error", err.Error()))
+		}
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			log.Fatal("import/FAILURE", logger.String("error", err.Error()))
+		}
+		defer file.Close()
+
+		if preview {
+			result, err := srv.Dependency.ImporterService.PreviewJournal(context.Background(), &importer_service.PreviewJournalParams{
+				Journal: file,
+			})
+			if err != nil {
+				log.Fatal("import/PREVIEW_FAILURE", logger.String("error", err.Error()))
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "LINE\tDATE\tDIRECTION\tACCOUNT\tAMOUNT\tDESCRIPTION")
+			for _, booking := range result.Bookings {
+				account := booking.Account
+				if booking.IsTransfer() {
+					account += " -> " + booking.Destination
+				}
+
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", booking.Line, booking.CreatedAt.Format(time.DateOnly), booking.Direction, account, booking.Amount, booking.Description)
+			}
+			w.Flush()
+
+			printUnsupported(result.Unsupported)
+
+			for _, rowError := range result.Errors {
+				fmt.Printf("Line %d: %s\n", rowError.Line, rowError.Reason)
+			}
+
+			fmt.Printf("%d entries parsed, %d directives unsupported, %d entries failed.\n", len(result.Bookings), len(result.Unsupported), len(result.Errors))
+			return
+		}
+
+		result, err := srv.Dependency.ImporterService.ImportJournal(context.Background(), &importer_service.ImportJournalParams{
+			Journal: file,
+		})
+		if err != nil {
+			log.Fatal("import/IMPORT_FAILURE", logger.String("error", err.Error()))
+		}
+
+		printUnsupported(result.Unsupported)
+
+		fmt.Printf("Imported %d transactions, %d directives unsupported.\n", len(result.TransactionIDs), len(result.Unsupported))
+	},
+}
+
+func printPreview(rows importer_entity.Rows, skipped importer_entity.Rows, rowErrors importer_entity.RowErrors, currency string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "LINE\tDATE\tDIRECTION\tAMOUNT\tDESCRIPTION")
+	for _, row := range rows {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s %d\t%s\n", row.Line, row.CreatedAt.Format(time.DateOnly), row.Direction, currency, row.Amount, row.Description)
+	}
+	w.Flush()
+
+	for _, row := range skipped {
+		fmt.Printf("Line %d: already imported as %s\n", row.Line, row.ExternalID)
+	}
+
+	for _, rowError := range rowErrors {
+		fmt.Printf("Line %d: %s\n", rowError.Line, rowError.Reason)
+	}
+
+	fmt.Printf("%d rows parsed, %d rows skipped, %d rows failed.\n", len(rows), len(skipped), len(rowErrors))
+}
+
+func printUnsupported(unsupported importer_entity.RowErrors) {
+	for _, directive := range unsupported {
+		fmt.Printf("Line %d: %s\n", directive.Line, directive.Reason)
+	}
+}
+
+func init() {
+	ImportCSVCmd.Flags().String("profile", "", "Name of the import profile mapping the CSV columns.")
+	ImportCSVCmd.Flags().String("account", "", "Name of the account the transactions belong to.")
+	ImportCSVCmd.Flags().Bool("preview", false, "Print the parsed rows without importing them.")
+	ImportCSVCmd.MarkFlagRequired("profile")
+	ImportCSVCmd.MarkFlagRequired("account")
+
+	ImportOFXCmd.Flags().String("account", "", "Name of the account the transactions belong to.")
+	ImportOFXCmd.Flags().Bool("preview", false, "Print the parsed entries without importing them.")
+	ImportOFXCmd.MarkFlagRequired("account")
+
+	ImportCamtCmd.Flags().String("account", "", "Name of the account the transactions belong to.")
+	ImportCamtCmd.Flags().Bool("preview", false, "Print the parsed entries without importing them.")
+	ImportCamtCmd.MarkFlagRequired("account")
+
+	ImportJournalCmd.Flags().Bool("preview", false, "Print the resolved entries without importing them.")
+
+	ImportCmd.AddCommand(ImportCSVCmd)
+	ImportCmd.AddCommand(ImportOFXCmd)
+	ImportCmd.AddCommand(ImportCamtCmd)
+	ImportCmd.AddCommand(ImportJournalCmd)
+}
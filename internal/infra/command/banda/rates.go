@@ -0,0 +1,60 @@
+package banda_command
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/config"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/config/version"
+	http_server "github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/http/server"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+
+	rate_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/service"
+)
+
+var RatesCmd = &cobra.Command{
+	Use:   "rates",
+	Short: "Manage exchange rates.",
+	Long:  `Manage exchange rates.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var RatesImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import exchange rates from a CSV file.",
+	Long:  `Import exchange rates from a CSV file with date, base, quote and rate columns. Rates already stored for the same date and currency pair are replaced.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		config.Init()
+		log := logger.New(version.Version, version.Build)
+
+		srv, err := http_server.New(log)
+		if err != nil {
+			log.Fatal("rates/FAILURE", logger.String("error", err.Error()))
+		}
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			log.Fatal("rates/FAILURE", logger.String("error", err.Error()))
+		}
+		defer file.Close()
+
+		result, err := srv.Dependency.RateService.ImportRates(context.Background(), &rate_service.ImportRatesParams{
+			CSV: file,
+		})
+		if err != nil {
+			log.Fatal("rates/IMPORT_FAILURE", logger.String("error", err.Error()))
+		}
+
+		fmt.Printf("Imported %d rates.\n", result.Imported)
+	},
+}
+
+func init() {
+	RatesCmd.AddCommand(RatesImportCmd)
+}
@@ -0,0 +1,31 @@
+package dbtest
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+)
+
+// Open connects to the database in DATABASE_URL, the one make migratedb
+// migrates, and skips the test when it is not set.
+func Open(t testing.TB) *sql.DB {
+	t.Helper()
+
+	url := os.Getenv("DATABASE_URL")
+	if url == "" {
+		t.Skip("DATABASE_URL is not set")
+	}
+
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	return db
+}
@@ -1,26 +1,160 @@
 package http_server
 
 import (
+	"github.com/spf13/viper"
+
+	account_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/controller"
+	account_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/repository"
+	account_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/account/service"
+	attachment_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/controller"
+	attachment_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/repository"
+	attachment_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/attachment/service"
+	category_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/controller"
+	category_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/repository"
+	category_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/category/service"
+	export_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/controller"
+	export_entity "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/entity"
+	export_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/export/service"
+	importer_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/controller"
+	importer_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/repository"
+	importer_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/importer/service"
+	ledger_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/controller"
+	ledger_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/repository"
+	ledger_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/ledger/service"
+	payee_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/controller"
+	payee_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/repository"
+	payee_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/payee/service"
+	rate_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/controller"
+	rate_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/repository"
+	rate_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rate/service"
+	reconciliation_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/controller"
+	reconciliation_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/repository"
+	reconciliation_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/reconciliation/service"
+	rule_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/controller"
+	rule_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/repository"
+	rule_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/rule/service"
 	subscription_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/controller"
 	subscription_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/repository"
 	subscription_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/subscription/service"
+	tag_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/controller"
+	tag_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/repository"
+	tag_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/tag/service"
 	transaction_controller "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/controller"
 	transaction_repository "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/repository"
 	transaction_service "github.com/fikrirnurhidayat/banda-lumaksa/internal/domain/transaction/service"
 )
 
 type Dependency struct {
-	TransactionRepository  transaction_repository.TransactionRepository
-	TransactionService     transaction_service.TransactionService
-	TransactionController  transaction_controller.TransactionController
-	SubscriptionRepository subscription_repository.SubscriptionRepository
-	SubscriptionService    subscription_service.SubscriptionService
-	SubscriptionController subscription_controller.SubscriptionController
+	AccountRepository             account_repository.AccountRepository
+	AccountService                account_service.AccountService
+	AccountController             account_controller.AccountController
+	AttachmentRepository          attachment_repository.AttachmentRepository
+	AttachmentService             attachment_service.AttachmentService
+	AttachmentController          attachment_controller.AttachmentController
+	CategoryRepository            category_repository.CategoryRepository
+	CategoryService               category_service.CategoryService
+	CategoryController            category_controller.CategoryController
+	ExportService                 export_service.ExportService
+	ExportController              export_controller.ExportController
+	ProfileRepository             importer_repository.ProfileRepository
+	ImporterService               importer_service.ImporterService
+	ImporterController            importer_controller.ImporterController
+	JournalEntryRepository        ledger_repository.JournalEntryRepository
+	PostingRepository             ledger_repository.PostingRepository
+	LedgerService                 ledger_service.LedgerService
+	LedgerController              ledger_controller.LedgerController
+	PayeeRepository               payee_repository.PayeeRepository
+	AliasRepository               payee_repository.AliasRepository
+	PayeeService                  payee_service.PayeeService
+	PayeeController               payee_controller.PayeeController
+	RateRepository                rate_repository.RateRepository
+	RateService                   rate_service.RateService
+	RateController                rate_controller.RateController
+	ReconciliationRepository      reconciliation_repository.ReconciliationRepository
+	ReconciliationService         reconciliation_service.ReconciliationService
+	ReconciliationController      reconciliation_controller.ReconciliationController
+	RuleRepository                rule_repository.RuleRepository
+	RuleService                   rule_service.RuleService
+	RuleController                rule_controller.RuleController
+	TagRepository                 tag_repository.TagRepository
+	TransactionTaggingRepository  tag_repository.TaggingRepository
+	SubscriptionTaggingRepository tag_repository.TaggingRepository
+	TagService                    tag_service.TagService
+	TagController                 tag_controller.TagController
+	TransactionRepository         transaction_repository.TransactionRepository
+	SearchRepository              transaction_repository.SearchRepository
+	SplitRepository               transaction_repository.SplitRepository
+	DuplicateRepository           transaction_repository.DuplicateRepository
+	TransactionService            transaction_service.TransactionService
+	TransactionController         transaction_controller.TransactionController
+	SubscriptionRepository        subscription_repository.SubscriptionRepository
+	SubscriptionService           subscription_service.SubscriptionService
+	SubscriptionController        subscription_controller.SubscriptionController
 }
 
 func (s *Server) Bootstrap() (err error) {
 	s.Dependency = &Dependency{}
 
+	s.Dependency.AccountRepository, err = account_repository.NewPostgresRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.AttachmentRepository, err = attachment_repository.NewPostgresRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.CategoryRepository, err = category_repository.NewPostgresRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.ProfileRepository, err = importer_repository.NewPostgresProfileRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.PayeeRepository, err = payee_repository.NewPostgresRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.AliasRepository, err = payee_repository.NewPostgresAliasRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.ReconciliationRepository, err = reconciliation_repository.NewPostgresRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.RateRepository, err = rate_repository.NewPostgresRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.RuleRepository, err = rule_repository.NewPostgresRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.TagRepository, err = tag_repository.NewPostgresRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.TransactionTaggingRepository, err = tag_repository.NewPostgresTransactionTaggingRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.SubscriptionTaggingRepository, err = tag_repository.NewPostgresSubscriptionTaggingRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
 	s.Dependency.SubscriptionRepository, err = subscription_repository.NewPostgresRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
 	if err != nil {
 		return err
@@ -31,13 +165,74 @@ func (s *Server) Bootstrap() (err error) {
 		return err
 	}
 
-	s.Dependency.TransactionService = transaction_service.New(s.Dependency.TransactionRepository)
-	s.Dependency.SubscriptionService = subscription_service.New(s.RootDependency.Logger, s.Dependency.SubscriptionRepository, s.Dependency.TransactionRepository, s.RootDependency.TransactionManager)
+	s.Dependency.SearchRepository = transaction_repository.NewPostgresSearchRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+
+	s.Dependency.SplitRepository, err = transaction_repository.NewPostgresSplitRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.DuplicateRepository, err = transaction_repository.NewPostgresDuplicateRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.JournalEntryRepository, err = ledger_repository.NewPostgresJournalEntryRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.PostingRepository, err = ledger_repository.NewPostgresPostingRepository(s.RootDependency.Logger, s.RootDependency.DatabaseManager)
+	if err != nil {
+		return err
+	}
+
+	s.Dependency.LedgerService = ledger_service.New(s.RootDependency.Logger, s.Dependency.JournalEntryRepository, s.Dependency.PostingRepository, s.RootDependency.TransactionManager)
+	s.Dependency.AccountService = account_service.New(s.RootDependency.Logger, s.Dependency.AccountRepository, s.Dependency.TransactionRepository, s.Dependency.SubscriptionRepository, s.Dependency.PostingRepository)
+	s.Dependency.AttachmentService = attachment_service.New(s.RootDependency.Logger, s.Dependency.AttachmentRepository, s.Dependency.TransactionRepository, s.Storage, s.RootDependency.TransactionManager)
+	s.Dependency.CategoryService = category_service.New(s.RootDependency.Logger, s.Dependency.CategoryRepository)
+	s.Dependency.PayeeService = payee_service.New(s.RootDependency.Logger, s.Dependency.PayeeRepository, s.Dependency.AliasRepository, s.RootDependency.TransactionManager)
+	s.Dependency.RateService = rate_service.New(s.RootDependency.Logger, s.Dependency.RateRepository, s.RootDependency.TransactionManager)
+	s.Dependency.TagService = tag_service.New(s.RootDependency.Logger, s.Dependency.TagRepository, s.Dependency.TransactionTaggingRepository, s.Dependency.SubscriptionTaggingRepository, s.RootDependency.TransactionManager)
+	s.Dependency.TransactionService = transaction_service.New(s.Dependency.TransactionRepository, s.Dependency.SearchRepository, s.Dependency.SplitRepository, s.Dependency.DuplicateRepository, s.Dependency.AccountRepository, s.Dependency.CategoryRepository, s.Dependency.PayeeRepository, s.Dependency.AliasRepository, s.Dependency.RuleRepository, s.Dependency.RateRepository, s.Dependency.TagService, s.Dependency.AttachmentService, s.RootDependency.TransactionManager, s.Dependency.LedgerService)
+	s.Dependency.ExportService = export_service.New(s.RootDependency.Logger, s.Dependency.TransactionRepository, s.Dependency.AccountRepository, s.Dependency.SubscriptionRepository, s.Dependency.PayeeRepository, export_entity.Accounts{
+		Assets:        viper.GetString("export.accounts.assets"),
+		Income:        viper.GetString("export.accounts.income"),
+		Expenses:      viper.GetString("export.accounts.expenses"),
+		Subscriptions: viper.GetString("export.accounts.subscriptions"),
+		Transfers:     viper.GetString("export.accounts.transfers"),
+	})
+	s.Dependency.ImporterService = importer_service.New(s.RootDependency.Logger, s.Dependency.ProfileRepository, s.Dependency.AccountRepository, s.Dependency.TransactionRepository, s.Dependency.TransactionService, s.RootDependency.TransactionManager)
+	s.Dependency.ReconciliationService = reconciliation_service.New(s.RootDependency.Logger, s.Dependency.ReconciliationRepository, s.Dependency.TransactionRepository, s.Dependency.AccountRepository, s.Dependency.PostingRepository, s.RootDependency.TransactionManager)
+	s.Dependency.RuleService = rule_service.New(s.RootDependency.Logger, s.Dependency.RuleRepository, s.Dependency.TransactionRepository, s.Dependency.TransactionService, s.RootDependency.TransactionManager)
+	s.Dependency.SubscriptionService = subscription_service.New(s.RootDependency.Logger, s.Dependency.SubscriptionRepository, s.Dependency.TransactionService, s.Dependency.TransactionRepository, s.Dependency.PayeeRepository, s.Dependency.AccountRepository, s.Dependency.CategoryRepository, s.Dependency.TagService, s.RootDependency.TransactionManager)
 
+	s.Dependency.AccountController = account_controller.New(s.Logger, s.Dependency.AccountService)
+	s.Dependency.AttachmentController = attachment_controller.New(s.Logger, s.Dependency.AttachmentService)
+	s.Dependency.CategoryController = category_controller.New(s.Logger, s.Dependency.CategoryService)
+	s.Dependency.ExportController = export_controller.New(s.Logger, s.Dependency.ExportService)
+	s.Dependency.ImporterController = importer_controller.New(s.Logger, s.Dependency.ImporterService)
+	s.Dependency.LedgerController = ledger_controller.New(s.Logger, s.Dependency.LedgerService)
+	s.Dependency.PayeeController = payee_controller.New(s.Logger, s.Dependency.PayeeService)
+	s.Dependency.RateController = rate_controller.New(s.Logger, s.Dependency.RateService)
+	s.Dependency.ReconciliationController = reconciliation_controller.New(s.Logger, s.Dependency.ReconciliationService)
+	s.Dependency.RuleController = rule_controller.New(s.Logger, s.Dependency.RuleService)
 	s.Dependency.SubscriptionController = subscription_controller.New(s.Logger, s.Dependency.SubscriptionService)
+	s.Dependency.TagController = tag_controller.New(s.Logger, s.Dependency.TagService)
 	s.Dependency.TransactionController = transaction_controller.New(s.Dependency.TransactionService)
 
+	s.Dependency.AccountController.Register(s.Echo)
+	s.Dependency.AttachmentController.Register(s.Echo)
+	s.Dependency.CategoryController.Register(s.Echo)
+	s.Dependency.ExportController.Register(s.Echo)
+	s.Dependency.ImporterController.Register(s.Echo)
+	s.Dependency.LedgerController.Register(s.Echo)
+	s.Dependency.PayeeController.Register(s.Echo)
+	s.Dependency.RateController.Register(s.Echo)
+	s.Dependency.ReconciliationController.Register(s.Echo)
+	s.Dependency.RuleController.Register(s.Echo)
 	s.Dependency.SubscriptionController.Register(s.Echo)
+	s.Dependency.TagController.Register(s.Echo)
 	s.Dependency.TransactionController.Register(s.Echo)
 
 	return nil
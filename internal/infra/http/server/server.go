@@ -15,12 +15,14 @@ import (
 
 	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/db"
 	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/logger"
+	"github.com/fikrirnurhidayat/banda-lumaksa/internal/infra/storage"
 )
 
 type Server struct {
 	Echo           *echo.Echo
 	Port           uint
 	DB             *sql.DB
+	Storage        storage.Storage
 	Logger         logger.Logger
 	RootDependency *common_module.RootDependency
 	Dependency     *Dependency
@@ -42,11 +44,17 @@ func New(logger logger.Logger) (*Server, error) {
 		return nil, err
 	}
 
+	store, err := storage.New()
+	if err != nil {
+		return nil, err
+	}
+
 	server := &Server{
-		Port:   viper.GetUint("server.port"),
-		Echo:   echo.New(),
-		DB:     db,
-		Logger: logger,
+		Port:    viper.GetUint("server.port"),
+		Echo:    echo.New(),
+		DB:      db,
+		Storage: store,
+		Logger:  logger,
 	}
 
 	server.RootDependency = common_module.New(server.DB, server.Logger)
@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/viper"
+)
+
+var ErrBlobNotFound = errors.New("storage: blob not found")
+
+// Storage keeps blobs of content under opaque keys chosen by the caller.
+type Storage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+func New() (Storage, error) {
+	switch driver := viper.GetString("storage.driver"); driver {
+	case "", "local":
+		return NewLocal(viper.GetString("storage.local.path"))
+	default:
+		return nil, fmt.Errorf("storage: unknown driver %q", driver)
+	}
+}
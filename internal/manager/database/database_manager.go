@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -55,6 +56,12 @@ func (m *DatabaseManagerImpl) Paginate(builder squirrel.SelectBuilder, specs ...
 			builder = builder.Limit(uint64(v.Limit))
 		case common_specification.OffsetSpecification:
 			builder = builder.Offset(uint64(v.Offset))
+		case common_specification.SortSpecification:
+			for _, arg := range v.Args {
+				builder = builder.OrderBy(strings.TrimSpace(arg.Column + " " + arg.Direction))
+			}
+		case common_specification.LockSpecification:
+			builder = builder.Suffix(v.Strength)
 		}
 	}
 
@@ -0,0 +1,54 @@
+package database_manager
+
+import (
+	"testing"
+
+	"github.com/Masterminds/squirrel"
+
+	common_specification "github.com/fikrirnurhidayat/banda-lumaksa/internal/common/specification"
+)
+
+func TestDatabaseManagerPaginate(t *testing.T) {
+	tests := []struct {
+		name  string
+		specs []common_specification.Specification
+		want  string
+	}{
+		{
+			name:  "no specifications",
+			specs: []common_specification.Specification{nil, nil},
+			want:  "SELECT id FROM transactions",
+		},
+		{
+			name: "sort, limit and offset",
+			specs: []common_specification.Specification{
+				common_specification.Sort(common_specification.SortArg{Column: "created_at", Direction: "DESC"}, common_specification.SortArg{Column: "id"}),
+				common_specification.WithLimit(10),
+				common_specification.WithOffset(20),
+			},
+			want: "SELECT id FROM transactions ORDER BY created_at DESC, id LIMIT 10 OFFSET 20",
+		},
+		{
+			name: "lock after the limit",
+			specs: []common_specification.Specification{
+				common_specification.WithLimit(1),
+				common_specification.ForUpdate(),
+			},
+			want: "SELECT id FROM transactions LIMIT 1 FOR UPDATE",
+		},
+	}
+
+	m := &DatabaseManagerImpl{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, _, err := m.Paginate(squirrel.Select("id").From("transactions"), tt.specs...).ToSql()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if query != tt.want {
+				t.Errorf("Paginate() = %q, want %q", query, tt.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 type TransactionManager interface {
 	Execute(ctx context.Context, fn func(context.Context) error) error
+	BeforeCommit(ctx context.Context, fn func(context.Context) error) error
+	AfterCommit(ctx context.Context, fn func(context.Context) error) error
 }
 
 type TransactionManagerImpl struct {
@@ -17,7 +19,17 @@ type TransactionManagerImpl struct {
 	logger logger.Logger
 }
 
+type hooks struct {
+	beforeCommit []func(context.Context) error
+	afterCommit  []func(context.Context) error
+}
+
 func (m *TransactionManagerImpl) Execute(ctx context.Context, fn func(context.Context) error) error {
+	if _, ok := ctx.Value(manager_values.TxKey{}).(*sql.Tx); ok {
+		m.logger.Debug("transaction/JOINED")
+		return fn(ctx)
+	}
+
 	tx, err := m.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		return err
@@ -25,7 +37,11 @@ func (m *TransactionManagerImpl) Execute(ctx context.Context, fn func(context.Co
 	
 	m.logger.Debug("transaction/STARTED")
 
-	if err := fn(context.WithValue(ctx, manager_values.TxKey{}, tx)); err != nil {
+	h := &hooks{}
+	ctx = context.WithValue(ctx, manager_values.TxKey{}, tx)
+	ctx = context.WithValue(ctx, manager_values.HookKey{}, h)
+
+	if err := m.run(ctx, fn, h); err != nil {
 		if err := tx.Rollback(); err != nil {
 			m.logger.Debug("transaction/ABORTED")
 			return err
@@ -41,6 +57,56 @@ func (m *TransactionManagerImpl) Execute(ctx context.Context, fn func(context.Co
 	}
 
 	m.logger.Debug("transaction/COMMITED")
+
+	ctx = context.WithValue(ctx, manager_values.TxKey{}, nil)
+	ctx = context.WithValue(ctx, manager_values.HookKey{}, nil)
+	for _, fn := range h.afterCommit {
+		if err := fn(ctx); err != nil {
+			m.logger.Error("transaction/AFTER_COMMIT_FAILURE", logger.String("error", err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// BeforeCommit registers fn to run after the outermost Execute callback has
+// succeeded and right before the transaction is committed. Outside of a
+// transaction fn runs immediately.
+func (m *TransactionManagerImpl) BeforeCommit(ctx context.Context, fn func(context.Context) error) error {
+	h, ok := ctx.Value(manager_values.HookKey{}).(*hooks)
+	if !ok {
+		return fn(ctx)
+	}
+
+	h.beforeCommit = append(h.beforeCommit, fn)
+	return nil
+}
+
+// AfterCommit registers fn to run once the transaction has been committed, for
+// side effects outside of the database that must not happen on rollback. Its
+// error is only logged since the transaction can no longer be undone. Outside
+// of a transaction fn runs immediately.
+func (m *TransactionManagerImpl) AfterCommit(ctx context.Context, fn func(context.Context) error) error {
+	h, ok := ctx.Value(manager_values.HookKey{}).(*hooks)
+	if !ok {
+		return fn(ctx)
+	}
+
+	h.afterCommit = append(h.afterCommit, fn)
+	return nil
+}
+
+func (m *TransactionManagerImpl) run(ctx context.Context, fn func(context.Context) error, h *hooks) error {
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(h.beforeCommit); i++ {
+		if err := h.beforeCommit[i](ctx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1,3 +1,5 @@
 package manager_values
 
 type TxKey struct{}
+
+type HookKey struct{}